@@ -0,0 +1,181 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        v5.29.3
+// source: openapiv3/file.proto
+
+package generator
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type File struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Security schemes added to components/securitySchemes.
+	SecuritySchemes []*AuthScheme `protobuf:"bytes,1,rep,name=security_schemes,json=securitySchemes,proto3" json:"security_schemes,omitempty"`
+	// Security of the operations of the file whose service and method don't
+	// declare their own. Any one of the requirements grants access.
+	Security []*AuthRequirement `protobuf:"bytes,2,rep,name=security,proto3" json:"security,omitempty"`
+}
+
+func (x *File) Reset() {
+	*x = File{}
+	mi := &file_openapiv3_file_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *File) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*File) ProtoMessage() {}
+
+func (x *File) ProtoReflect() protoreflect.Message {
+	mi := &file_openapiv3_file_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use File.ProtoReflect.Descriptor instead.
+func (*File) Descriptor() ([]byte, []int) {
+	return file_openapiv3_file_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *File) GetSecuritySchemes() []*AuthScheme {
+	if x != nil {
+		return x.SecuritySchemes
+	}
+	return nil
+}
+
+func (x *File) GetSecurity() []*AuthRequirement {
+	if x != nil {
+		return x.Security
+	}
+	return nil
+}
+
+var file_openapiv3_file_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*File)(nil),
+		Field:         1144,
+		Name:          "openapi.v3.file",
+		Tag:           "bytes,1144,opt,name=file",
+		Filename:      "openapiv3/file.proto",
+	},
+}
+
+// Extension fields to descriptorpb.FileOptions.
+var (
+	// optional openapi.v3.File file = 1144;
+	E_File = &file_openapiv3_file_proto_extTypes[0]
+)
+
+var File_openapiv3_file_proto protoreflect.FileDescriptor
+
+var file_openapiv3_file_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x33, 0x2f, 0x66, 0x69, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x33, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x33, 0x2f,
+	0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x82,
+	0x01, 0x0a, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x41, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x41,
+	0x75, 0x74, 0x68, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x08, 0x73, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x73, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x3a, 0x43, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69,
+	0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xf8, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x46, 0x69,
+	0x6c, 0x65, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x75, 0x62, 0x67, 0x6f, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2f,
+	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x3b, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_openapiv3_file_proto_rawDescOnce sync.Once
+	file_openapiv3_file_proto_rawDescData = file_openapiv3_file_proto_rawDesc
+)
+
+func file_openapiv3_file_proto_rawDescGZIP() []byte {
+	file_openapiv3_file_proto_rawDescOnce.Do(func() {
+		file_openapiv3_file_proto_rawDescData = protoimpl.X.CompressGZIP(file_openapiv3_file_proto_rawDescData)
+	})
+	return file_openapiv3_file_proto_rawDescData
+}
+
+var file_openapiv3_file_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_openapiv3_file_proto_goTypes = []any{
+	(*File)(nil),                     // 0: openapi.v3.File
+	(*AuthScheme)(nil),               // 1: openapi.v3.AuthScheme
+	(*AuthRequirement)(nil),          // 2: openapi.v3.AuthRequirement
+	(*descriptorpb.FileOptions)(nil), // 3: google.protobuf.FileOptions
+}
+var file_openapiv3_file_proto_depIdxs = []int32{
+	1, // 0: openapi.v3.File.security_schemes:type_name -> openapi.v3.AuthScheme
+	2, // 1: openapi.v3.File.security:type_name -> openapi.v3.AuthRequirement
+	3, // 2: openapi.v3.file:extendee -> google.protobuf.FileOptions
+	0, // 3: openapi.v3.file:type_name -> openapi.v3.File
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	3, // [3:4] is the sub-list for extension type_name
+	2, // [2:3] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_openapiv3_file_proto_init() }
+func file_openapiv3_file_proto_init() {
+	if File_openapiv3_file_proto != nil {
+		return
+	}
+	file_openapiv3_security_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_openapiv3_file_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 1,
+			NumServices:   0,
+		},
+		GoTypes:           file_openapiv3_file_proto_goTypes,
+		DependencyIndexes: file_openapiv3_file_proto_depIdxs,
+		MessageInfos:      file_openapiv3_file_proto_msgTypes,
+		ExtensionInfos:    file_openapiv3_file_proto_extTypes,
+	}.Build()
+	File_openapiv3_file_proto = out.File
+	file_openapiv3_file_proto_rawDesc = nil
+	file_openapiv3_file_proto_goTypes = nil
+	file_openapiv3_file_proto_depIdxs = nil
+}
@@ -0,0 +1,669 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        v5.29.3
+// source: openapiv3/security.proto
+
+package generator
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// A security scheme documented in components/securitySchemes.
+type AuthScheme struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the scheme, which security requirements refer to.
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Types that are assignable to Type:
+	//	*AuthScheme_ApiKey
+	//	*AuthScheme_Bearer
+	//	*AuthScheme_Basic
+	//	*AuthScheme_Oauth2
+	//	*AuthScheme_OpenIdConnectUrl
+	//	*AuthScheme_MutualTls
+	Type isAuthScheme_Type `protobuf_oneof:"type"`
+}
+
+func (x *AuthScheme) Reset() {
+	*x = AuthScheme{}
+	mi := &file_openapiv3_security_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthScheme) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthScheme) ProtoMessage() {}
+
+func (x *AuthScheme) ProtoReflect() protoreflect.Message {
+	mi := &file_openapiv3_security_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthScheme.ProtoReflect.Descriptor instead.
+func (*AuthScheme) Descriptor() ([]byte, []int) {
+	return file_openapiv3_security_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AuthScheme) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AuthScheme) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (m *AuthScheme) GetType() isAuthScheme_Type {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+func (x *AuthScheme) GetApiKey() *AuthApiKey {
+	if x, ok := x.GetType().(*AuthScheme_ApiKey); ok {
+		return x.ApiKey
+	}
+	return nil
+}
+
+func (x *AuthScheme) GetBearer() *AuthBearer {
+	if x, ok := x.GetType().(*AuthScheme_Bearer); ok {
+		return x.Bearer
+	}
+	return nil
+}
+
+func (x *AuthScheme) GetBasic() bool {
+	if x, ok := x.GetType().(*AuthScheme_Basic); ok {
+		return x.Basic
+	}
+	return false
+}
+
+func (x *AuthScheme) GetOauth2() *AuthOAuth2 {
+	if x, ok := x.GetType().(*AuthScheme_Oauth2); ok {
+		return x.Oauth2
+	}
+	return nil
+}
+
+func (x *AuthScheme) GetOpenIdConnectUrl() string {
+	if x, ok := x.GetType().(*AuthScheme_OpenIdConnectUrl); ok {
+		return x.OpenIdConnectUrl
+	}
+	return ""
+}
+
+func (x *AuthScheme) GetMutualTls() bool {
+	if x, ok := x.GetType().(*AuthScheme_MutualTls); ok {
+		return x.MutualTls
+	}
+	return false
+}
+
+type isAuthScheme_Type interface {
+	isAuthScheme_Type()
+}
+
+type AuthScheme_ApiKey struct {
+	ApiKey *AuthApiKey `protobuf:"bytes,3,opt,name=api_key,json=apiKey,proto3,oneof"`
+}
+
+type AuthScheme_Bearer struct {
+	Bearer *AuthBearer `protobuf:"bytes,4,opt,name=bearer,proto3,oneof"`
+}
+
+type AuthScheme_Basic struct {
+	// HTTP basic authentication.
+	Basic bool `protobuf:"varint,5,opt,name=basic,proto3,oneof"`
+}
+
+type AuthScheme_Oauth2 struct {
+	Oauth2 *AuthOAuth2 `protobuf:"bytes,6,opt,name=oauth2,proto3,oneof"`
+}
+
+type AuthScheme_OpenIdConnectUrl struct {
+	// URL of the OpenID Connect discovery document.
+	OpenIdConnectUrl string `protobuf:"bytes,7,opt,name=open_id_connect_url,json=openIdConnectUrl,proto3,oneof"`
+}
+
+type AuthScheme_MutualTls struct {
+	// Mutual TLS, the client authenticates with its certificate.
+	MutualTls bool `protobuf:"varint,8,opt,name=mutual_tls,json=mutualTls,proto3,oneof"`
+}
+
+func (*AuthScheme_ApiKey) isAuthScheme_Type() {}
+
+func (*AuthScheme_Bearer) isAuthScheme_Type() {}
+
+func (*AuthScheme_Basic) isAuthScheme_Type() {}
+
+func (*AuthScheme_Oauth2) isAuthScheme_Type() {}
+
+func (*AuthScheme_OpenIdConnectUrl) isAuthScheme_Type() {}
+
+func (*AuthScheme_MutualTls) isAuthScheme_Type() {}
+
+type AuthApiKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the header, query parameter or cookie.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Location of the key: header, query or cookie.
+	In string `protobuf:"bytes,2,opt,name=in,proto3" json:"in,omitempty"`
+}
+
+func (x *AuthApiKey) Reset() {
+	*x = AuthApiKey{}
+	mi := &file_openapiv3_security_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthApiKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthApiKey) ProtoMessage() {}
+
+func (x *AuthApiKey) ProtoReflect() protoreflect.Message {
+	mi := &file_openapiv3_security_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthApiKey.ProtoReflect.Descriptor instead.
+func (*AuthApiKey) Descriptor() ([]byte, []int) {
+	return file_openapiv3_security_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AuthApiKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AuthApiKey) GetIn() string {
+	if x != nil {
+		return x.In
+	}
+	return ""
+}
+
+type AuthBearer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Format of the token, like JWT.
+	Format string `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+}
+
+func (x *AuthBearer) Reset() {
+	*x = AuthBearer{}
+	mi := &file_openapiv3_security_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthBearer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthBearer) ProtoMessage() {}
+
+func (x *AuthBearer) ProtoReflect() protoreflect.Message {
+	mi := &file_openapiv3_security_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthBearer.ProtoReflect.Descriptor instead.
+func (*AuthBearer) Descriptor() ([]byte, []int) {
+	return file_openapiv3_security_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AuthBearer) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type AuthOAuth2 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Implicit          *AuthFlow `protobuf:"bytes,1,opt,name=implicit,proto3" json:"implicit,omitempty"`
+	Password          *AuthFlow `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	ClientCredentials *AuthFlow `protobuf:"bytes,3,opt,name=client_credentials,json=clientCredentials,proto3" json:"client_credentials,omitempty"`
+	AuthorizationCode *AuthFlow `protobuf:"bytes,4,opt,name=authorization_code,json=authorizationCode,proto3" json:"authorization_code,omitempty"`
+}
+
+func (x *AuthOAuth2) Reset() {
+	*x = AuthOAuth2{}
+	mi := &file_openapiv3_security_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthOAuth2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthOAuth2) ProtoMessage() {}
+
+func (x *AuthOAuth2) ProtoReflect() protoreflect.Message {
+	mi := &file_openapiv3_security_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthOAuth2.ProtoReflect.Descriptor instead.
+func (*AuthOAuth2) Descriptor() ([]byte, []int) {
+	return file_openapiv3_security_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AuthOAuth2) GetImplicit() *AuthFlow {
+	if x != nil {
+		return x.Implicit
+	}
+	return nil
+}
+
+func (x *AuthOAuth2) GetPassword() *AuthFlow {
+	if x != nil {
+		return x.Password
+	}
+	return nil
+}
+
+func (x *AuthOAuth2) GetClientCredentials() *AuthFlow {
+	if x != nil {
+		return x.ClientCredentials
+	}
+	return nil
+}
+
+func (x *AuthOAuth2) GetAuthorizationCode() *AuthFlow {
+	if x != nil {
+		return x.AuthorizationCode
+	}
+	return nil
+}
+
+type AuthFlow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuthorizationUrl string       `protobuf:"bytes,1,opt,name=authorization_url,json=authorizationUrl,proto3" json:"authorization_url,omitempty"`
+	TokenUrl         string       `protobuf:"bytes,2,opt,name=token_url,json=tokenUrl,proto3" json:"token_url,omitempty"`
+	RefreshUrl       string       `protobuf:"bytes,3,opt,name=refresh_url,json=refreshUrl,proto3" json:"refresh_url,omitempty"`
+	Scopes           []*AuthScope `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+}
+
+func (x *AuthFlow) Reset() {
+	*x = AuthFlow{}
+	mi := &file_openapiv3_security_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthFlow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthFlow) ProtoMessage() {}
+
+func (x *AuthFlow) ProtoReflect() protoreflect.Message {
+	mi := &file_openapiv3_security_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthFlow.ProtoReflect.Descriptor instead.
+func (*AuthFlow) Descriptor() ([]byte, []int) {
+	return file_openapiv3_security_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AuthFlow) GetAuthorizationUrl() string {
+	if x != nil {
+		return x.AuthorizationUrl
+	}
+	return ""
+}
+
+func (x *AuthFlow) GetTokenUrl() string {
+	if x != nil {
+		return x.TokenUrl
+	}
+	return ""
+}
+
+func (x *AuthFlow) GetRefreshUrl() string {
+	if x != nil {
+		return x.RefreshUrl
+	}
+	return ""
+}
+
+func (x *AuthFlow) GetScopes() []*AuthScope {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+type AuthScope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *AuthScope) Reset() {
+	*x = AuthScope{}
+	mi := &file_openapiv3_security_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthScope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthScope) ProtoMessage() {}
+
+func (x *AuthScope) ProtoReflect() protoreflect.Message {
+	mi := &file_openapiv3_security_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthScope.ProtoReflect.Descriptor instead.
+func (*AuthScope) Descriptor() ([]byte, []int) {
+	return file_openapiv3_security_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AuthScope) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AuthScope) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// A security requirement on a single scheme.
+type AuthRequirement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the security scheme.
+	Scheme string `protobuf:"bytes,1,opt,name=scheme,proto3" json:"scheme,omitempty"`
+	// Scopes of OAuth2 and OpenID Connect schemes, or roles of the other
+	// schemes, required to call the operation.
+	Scopes []string `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"`
+}
+
+func (x *AuthRequirement) Reset() {
+	*x = AuthRequirement{}
+	mi := &file_openapiv3_security_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthRequirement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthRequirement) ProtoMessage() {}
+
+func (x *AuthRequirement) ProtoReflect() protoreflect.Message {
+	mi := &file_openapiv3_security_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthRequirement.ProtoReflect.Descriptor instead.
+func (*AuthRequirement) Descriptor() ([]byte, []int) {
+	return file_openapiv3_security_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AuthRequirement) GetScheme() string {
+	if x != nil {
+		return x.Scheme
+	}
+	return ""
+}
+
+func (x *AuthRequirement) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+var File_openapiv3_security_proto protoreflect.FileDescriptor
+
+var file_openapiv3_security_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x33, 0x2f, 0x73, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x6f, 0x70, 0x65, 0x6e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x22, 0xcb, 0x02, 0x0a, 0x0a, 0x41, 0x75, 0x74, 0x68, 0x53,
+	0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x07, 0x61,
+	0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x41, 0x70,
+	0x69, 0x4b, 0x65, 0x79, 0x48, 0x00, 0x52, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x12, 0x30,
+	0x0a, 0x06, 0x62, 0x65, 0x61, 0x72, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x75, 0x74, 0x68,
+	0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x48, 0x00, 0x52, 0x06, 0x62, 0x65, 0x61, 0x72, 0x65, 0x72,
+	0x12, 0x16, 0x0a, 0x05, 0x62, 0x61, 0x73, 0x69, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x00, 0x52, 0x05, 0x62, 0x61, 0x73, 0x69, 0x63, 0x12, 0x30, 0x0a, 0x06, 0x6f, 0x61, 0x75, 0x74,
+	0x68, 0x32, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x32,
+	0x48, 0x00, 0x52, 0x06, 0x6f, 0x61, 0x75, 0x74, 0x68, 0x32, 0x12, 0x2f, 0x0a, 0x13, 0x6f, 0x70,
+	0x65, 0x6e, 0x5f, 0x69, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72,
+	0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x10, 0x6f, 0x70, 0x65, 0x6e, 0x49,
+	0x64, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x1f, 0x0a, 0x0a, 0x6d,
+	0x75, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x74, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x00, 0x52, 0x09, 0x6d, 0x75, 0x74, 0x75, 0x61, 0x6c, 0x54, 0x6c, 0x73, 0x42, 0x06, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x22, 0x30, 0x0a, 0x0a, 0x41, 0x75, 0x74, 0x68, 0x41, 0x70, 0x69, 0x4b,
+	0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x6e, 0x22, 0x24, 0x0a, 0x0a, 0x41, 0x75, 0x74, 0x68, 0x42, 0x65,
+	0x61, 0x72, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0xfa, 0x01, 0x0a,
+	0x0a, 0x41, 0x75, 0x74, 0x68, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x32, 0x12, 0x30, 0x0a, 0x08, 0x69,
+	0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x46,
+	0x6c, 0x6f, 0x77, 0x52, 0x08, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x12, 0x30, 0x0a,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x75, 0x74,
+	0x68, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12,
+	0x43, 0x0a, 0x12, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x46, 0x6c, 0x6f,
+	0x77, 0x52, 0x11, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x73, 0x12, 0x43, 0x0a, 0x12, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x75,
+	0x74, 0x68, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x11, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xa4, 0x01, 0x0a, 0x08, 0x41, 0x75,
+	0x74, 0x68, 0x46, 0x6c, 0x6f, 0x77, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x10, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x55, 0x72, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x75, 0x72, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x55, 0x72, 0x6c,
+	0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x75, 0x72, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x55, 0x72,
+	0x6c, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x33, 0x2e, 0x41,
+	0x75, 0x74, 0x68, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73,
+	0x22, 0x41, 0x0a, 0x09, 0x41, 0x75, 0x74, 0x68, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x41, 0x0a, 0x0f, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x75, 0x62, 0x67, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x3b, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_openapiv3_security_proto_rawDescOnce sync.Once
+	file_openapiv3_security_proto_rawDescData = file_openapiv3_security_proto_rawDesc
+)
+
+func file_openapiv3_security_proto_rawDescGZIP() []byte {
+	file_openapiv3_security_proto_rawDescOnce.Do(func() {
+		file_openapiv3_security_proto_rawDescData = protoimpl.X.CompressGZIP(file_openapiv3_security_proto_rawDescData)
+	})
+	return file_openapiv3_security_proto_rawDescData
+}
+
+var file_openapiv3_security_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_openapiv3_security_proto_goTypes = []any{
+	(*AuthScheme)(nil),      // 0: openapi.v3.AuthScheme
+	(*AuthApiKey)(nil),      // 1: openapi.v3.AuthApiKey
+	(*AuthBearer)(nil),      // 2: openapi.v3.AuthBearer
+	(*AuthOAuth2)(nil),      // 3: openapi.v3.AuthOAuth2
+	(*AuthFlow)(nil),        // 4: openapi.v3.AuthFlow
+	(*AuthScope)(nil),       // 5: openapi.v3.AuthScope
+	(*AuthRequirement)(nil), // 6: openapi.v3.AuthRequirement
+}
+var file_openapiv3_security_proto_depIdxs = []int32{
+	1, // 0: openapi.v3.AuthScheme.api_key:type_name -> openapi.v3.AuthApiKey
+	2, // 1: openapi.v3.AuthScheme.bearer:type_name -> openapi.v3.AuthBearer
+	3, // 2: openapi.v3.AuthScheme.oauth2:type_name -> openapi.v3.AuthOAuth2
+	4, // 3: openapi.v3.AuthOAuth2.implicit:type_name -> openapi.v3.AuthFlow
+	4, // 4: openapi.v3.AuthOAuth2.password:type_name -> openapi.v3.AuthFlow
+	4, // 5: openapi.v3.AuthOAuth2.client_credentials:type_name -> openapi.v3.AuthFlow
+	4, // 6: openapi.v3.AuthOAuth2.authorization_code:type_name -> openapi.v3.AuthFlow
+	5, // 7: openapi.v3.AuthFlow.scopes:type_name -> openapi.v3.AuthScope
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_openapiv3_security_proto_init() }
+func file_openapiv3_security_proto_init() {
+	if File_openapiv3_security_proto != nil {
+		return
+	}
+	file_openapiv3_security_proto_msgTypes[0].OneofWrappers = []any{
+		(*AuthScheme_ApiKey)(nil),
+		(*AuthScheme_Bearer)(nil),
+		(*AuthScheme_Basic)(nil),
+		(*AuthScheme_Oauth2)(nil),
+		(*AuthScheme_OpenIdConnectUrl)(nil),
+		(*AuthScheme_MutualTls)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_openapiv3_security_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_openapiv3_security_proto_goTypes,
+		DependencyIndexes: file_openapiv3_security_proto_depIdxs,
+		MessageInfos:      file_openapiv3_security_proto_msgTypes,
+	}.Build()
+	File_openapiv3_security_proto = out.File
+	file_openapiv3_security_proto_rawDesc = nil
+	file_openapiv3_security_proto_goTypes = nil
+	file_openapiv3_security_proto_depIdxs = nil
+}
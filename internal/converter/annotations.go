@@ -14,6 +14,7 @@ type annotator struct{}
 func (*annotator) AnnotateMessage(opts options.Options, schema *base.Schema, desc protoreflect.MessageDescriptor) *base.Schema {
 	schema = protovalidate.SchemaWithMessageAnnotations(opts, schema, desc)
 	schema = gnostic.SchemaWithSchemaAnnotations(schema, desc)
+	schema = googleapi.SchemaWithResourceMessageAnnotations(opts, schema, desc)
 	return schema
 }
 
@@ -21,6 +22,7 @@ func (*annotator) AnnotateField(opts options.Options, schema *base.Schema, desc
 	schema = protovalidate.SchemaWithFieldAnnotations(opts, schema, desc, onlyScalar)
 	schema = gnostic.SchemaWithPropertyAnnotations(schema, desc)
 	schema = googleapi.SchemaWithPropertyAnnotations(opts, schema, desc)
+	schema = googleapi.SchemaWithResourceAnnotations(opts, schema, desc)
 	return schema
 }
 
@@ -10,6 +10,7 @@ import (
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"gopkg.in/yaml.v3"
 
+	"github.com/pubgo/protoc-gen-openapi/internal/converter/googleapi"
 	"github.com/pubgo/protoc-gen-openapi/internal/converter/options"
 )
 
@@ -40,14 +41,26 @@ func fileToComponents(opts options.Options, fd protoreflect.FileDescriptor) (*v3
 	services := fd.Services()
 	for i := 0; i < services.Len(); i++ {
 		service := services.Get(i)
+		if !hasService(opts, service) {
+			continue
+		}
 		methods := service.Methods()
 		for j := 0; j < methods.Len(); j++ {
 			method := methods.Get(j)
+			if !hasMethod(opts, method) {
+				continue
+			}
 			hasGet := methodHasGet(opts, method)
 			if hasGet {
 				hasGetRequests = true
 			}
 			hasMethods = true
+
+			for _, resource := range googleapi.PartialResources(opts, method) {
+				if s := googleapi.PartialSchema(opts, resource); s != nil {
+					components.Schemas.Set(googleapi.PartialSchemaID(resource), base.CreateSchemaProxy(s))
+				}
+			}
 		}
 	}
 
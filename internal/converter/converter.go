@@ -23,6 +23,7 @@ import (
 	"gopkg.in/yaml.v3"
 
 	"github.com/pubgo/protoc-gen-openapi/internal/converter/gnostic"
+	"github.com/pubgo/protoc-gen-openapi/internal/converter/googleapi"
 	"github.com/pubgo/protoc-gen-openapi/internal/converter/options"
 	"github.com/pubgo/protoc-gen-openapi/internal/converter/util"
 )
@@ -116,58 +117,70 @@ func ConvertWithOptions(req *pluginpb.CodeGeneratorRequest, opts options.Options
 		return nil, err
 	}
 
-	spec, err := newSpec()
-	if err != nil {
-		return nil, err
-	}
 	outFiles := map[string]*v3.Document{}
-
-	for _, fileDesc := range req.GetProtoFile() {
-		if _, ok := genFiles[fileDesc.GetName()]; !ok {
-			continue
-		}
-
-		slog.Debug("generating file", slog.String("name", fileDesc.GetName()))
-
-		fd, err := resolver.FindFileByPath(fileDesc.GetName())
+	// The operations of HTTP methods without a path item field, for every document.
+	additional := map[*v3.Document]*util.AdditionalOperations{}
+	// Every variant is generated from the same descriptors, with its own options.
+	for _, opts := range opts.VariantOptions() {
+		spec, err := newSpec()
 		if err != nil {
-			slog.Error("error loading file", slog.Any("error", err))
 			return nil, err
 		}
 
-		// Create a per-file openapi spec if we're not merging all into one
-		if opts.Path == "" {
-			spec, err = newSpec()
+		for _, fileDesc := range req.GetProtoFile() {
+			if _, ok := genFiles[fileDesc.GetName()]; !ok {
+				continue
+			}
+
+			slog.Debug("generating file", slog.String("name", fileDesc.GetName()))
+
+			fd, err := resolver.FindFileByPath(fileDesc.GetName())
 			if err != nil {
+				slog.Error("error loading file", slog.Any("error", err))
 				return nil, err
 			}
-			spec.Info.Title = string(fd.FullName())
-			spec.Info.Description = util.FormatComments(fd.SourceLocations().ByDescriptor(fd))
-		}
 
-		if err := appendToSpec(opts, spec, fd); err != nil {
-			return nil, err
-		}
+			// Create a per-file openapi spec if we're not merging all into one
+			if opts.Path == "" {
+				spec, err = newSpec()
+				if err != nil {
+					return nil, err
+				}
+				spec.Info.Title = string(fd.FullName())
+				spec.Info.Description = util.FormatComments(opts, fd.SourceLocations().ByDescriptor(fd))
+			}
 
-		if opts.Path == "" {
-			name := fileDesc.GetName()
-			filename := strings.TrimSuffix(name, filepath.Ext(name)) + ".openapi." + opts.Format
-			outFiles[filename] = spec
-		}
+			if additional[spec] == nil {
+				additional[spec] = util.NewAdditionalOperations()
+			}
+			if err := appendToSpec(opts, spec, additional[spec], fd); err != nil {
+				return nil, err
+			}
+
+			if opts.Path == "" {
+				name := fileDesc.GetName()
+				filename := strings.TrimSuffix(name, filepath.Ext(name)) + ".openapi." + opts.Format
+				outFiles[filename] = spec
+			}
 
-		spec.Tags = mergeTags(spec.Tags)
-		if overrideComponents != nil {
-			util.AppendComponents(spec, overrideComponents)
+			spec.Tags = mergeTags(spec.Tags)
+			if overrideComponents != nil {
+				util.AppendComponents(spec, overrideComponents)
+			}
 		}
-	}
 
-	if opts.Path != "" {
-		outFiles[opts.Path] = spec
+		if opts.Path != "" {
+			applyVariant(opts, spec)
+			outFiles[opts.Path] = spec
+		}
 	}
 
 	for path, spec := range outFiles {
 		path := path
 		spec := spec
+		googleapi.LinkOperations(spec, additional[spec])
+		reportUnsecured(opts, path, spec, additional[spec])
+		additional[spec].Render(spec)
 		content, err := specToFile(opts, spec)
 		if err != nil {
 			return nil, err
@@ -254,7 +267,7 @@ func specToFile(opts options.Options, spec *v3.Document) (string, error) {
 	}
 }
 
-func appendToSpec(opts options.Options, spec *v3.Document, fd protoreflect.FileDescriptor) error {
+func appendToSpec(opts options.Options, spec *v3.Document, additional *util.AdditionalOperations, fd protoreflect.FileDescriptor) error {
 	gnostic.SpecWithFileAnnotations(spec, fd)
 	components, err := fileToComponents(opts, fd)
 	if err != nil {
@@ -266,7 +279,7 @@ func appendToSpec(opts options.Options, spec *v3.Document, fd protoreflect.FileD
 	appendServiceDocs(opts, spec, fd)
 	util.AppendComponents(spec, components)
 
-	if err := addPathItemsFromFileV1(opts, fd, spec.Paths); err != nil {
+	if err := addPathItemsFromFileV1(opts, fd, spec.Paths, additional); err != nil {
 		return err
 	}
 	spec.Tags = append(spec.Tags, fileToTags(opts, fd)...)
@@ -285,7 +298,7 @@ func appendServiceDocs(opts options.Options, spec *v3.Document, fd protoreflect.
 	services := fd.Services()
 	for i := 0; i < services.Len(); i++ {
 		service := services.Get(i)
-		if !opts.HasService(service.FullName()) {
+		if !hasService(opts, service) {
 			continue
 		}
 
@@ -294,7 +307,7 @@ func appendServiceDocs(opts options.Options, spec *v3.Document, fd protoreflect.
 		builder.WriteString("\n\n")
 
 		loc := fd.SourceLocations().ByDescriptor(service)
-		serviceComments := util.FormatComments(loc)
+		serviceComments := util.FormatComments(opts, loc)
 		if serviceComments != "" {
 			builder.WriteString(serviceComments)
 			builder.WriteString("\n\n")
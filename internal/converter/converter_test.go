@@ -8,14 +8,17 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"slices"
 	"strings"
 	"testing"
 
 	"github.com/pb33f/libopenapi"
 	validator "github.com/pb33f/libopenapi-validator"
 	"github.com/pb33f/libopenapi/datamodel"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
 	"github.com/pubgo/protoc-gen-openapi/internal/converter"
 	"github.com/pubgo/protoc-gen-openapi/internal/converter/options"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
@@ -35,6 +38,31 @@ var scenarios = []Scenario{
 	{Name: "with_specification_extensions", Options: "base=testdata/with_specification_extensions/base.yaml,trim-unused-types"},
 	{Name: "additional_bindings"},
 	{Name: "with_override", Options: "override=testdata/with_override/override.yaml"},
+	{Name: "int64_encoding", Options: "int64-encoding=string,with-special-float-values"},
+	{Name: "int64_both"},
+	{Name: "int64_integer", Options: "int64-encoding=integer"},
+	{Name: "query_params", Options: "query-params-max-depth=2"},
+	{Name: "update_mask"},
+	{Name: "pagination", Options: "default-page-size=25,max-page-size=100"},
+	{Name: "long_running"},
+	{Name: "resource"},
+	{Name: "singulars", Options: "singulars=octopi:octopus"},
+	{Name: "path_templates"},
+	{Name: "custom_methods"},
+	{Name: "http_body", Options: "content-types=json;proto"},
+	{Name: "multipart"},
+	{Name: "bound_params"},
+	{Name: "response_headers"},
+	{Name: "service_options"},
+	{Name: "enum_values"},
+	{Name: "enum_one_of", Options: "enum-one-of"},
+	{Name: "enum_prefix", Options: "without-enum-default,trim-enum-prefix"},
+	{Name: "enum_unspecified", Options: "omit-enum-unspecified"},
+	{Name: "security", Options: "report-unsecured"},
+	{Name: "visibility", Options: "visibility=public"},
+	{Name: "filters", Options: "filter=lava.*.v1.*;!*.Internal*;!path:/v1/admin/**,trim-unused-types"},
+	{Name: "comments", Options: "comment-summaries,with-detached-comments,comment-directives=NOLINT"},
+	{Name: "locale", Options: "locale=zh,locale-fallback=en,comment-summaries"},
 }
 
 type Scenario struct {
@@ -274,3 +302,115 @@ paths:
 		assert.Contains(t, content, "TestMessage")
 	})
 }
+
+func TestLongRunningOperationLinks(t *testing.T) {
+	f, err := os.ReadFile(filepath.Join("testdata", "fileset.binpb"))
+	require.NoError(t, err)
+	pf := new(descriptorpb.FileDescriptorSet)
+	require.NoError(t, proto.Unmarshal(f, pf))
+
+	// The Operations service only shows up next to the long-running methods when both files go into one document.
+	req := &pluginpb.CodeGeneratorRequest{
+		ProtoFile:      pf.GetFile(),
+		FileToGenerate: []string{"google/longrunning/operations.proto", "long_running/long_running.proto"},
+	}
+	opts := options.NewOptions()
+	opts.Path = "long_running.openapi.yaml"
+
+	resp, err := converter.ConvertWithOptions(req, opts)
+	require.NoError(t, err)
+	require.Len(t, resp.File, 1)
+
+	document, err := libopenapi.NewDocument([]byte(resp.File[0].GetContent()))
+	require.NoError(t, err)
+	model, errs := document.BuildV3Model()
+	require.Empty(t, errs)
+
+	for _, path := range []string{"/v1/books", "/long_running.Library/ExportBooks"} {
+		item, ok := model.Model.Paths.PathItems.Get(path)
+		require.True(t, ok, path)
+		rsp, ok := item.Post.Responses.Codes.Get("200")
+		require.True(t, ok, path)
+		require.NotNil(t, rsp.Links, path)
+
+		get, ok := rsp.Links.Get("getOperation")
+		require.True(t, ok, path)
+		assert.Equal(t, "google.longrunning.Operations.GetOperation", get.OperationId)
+		assert.Equal(t, 1, get.Parameters.Len())
+		assert.Equal(t, "$response.body#/name", get.Parameters.First().Value())
+
+		cancel, ok := rsp.Links.Get("cancelOperation")
+		require.True(t, ok, path)
+		assert.Equal(t, "google.longrunning.Operations.CancelOperation", cancel.OperationId)
+	}
+}
+
+func TestVariants(t *testing.T) {
+	f, err := os.ReadFile(filepath.Join("testdata", "fileset.binpb"))
+	require.NoError(t, err)
+	pf := new(descriptorpb.FileDescriptorSet)
+	require.NoError(t, proto.Unmarshal(f, pf))
+
+	req := &pluginpb.CodeGeneratorRequest{
+		ProtoFile:      pf.GetFile(),
+		FileToGenerate: []string{"variants/variants.proto"},
+		Parameter:      proto.String("variants=testdata/variants/variants.yaml"),
+	}
+	resp, err := converter.ConvertFrom(bytes.NewBuffer(lo.Must(proto.Marshal(req))))
+	require.NoError(t, err)
+	require.Len(t, resp.File, 3)
+
+	models := map[string]*v3.Document{}
+	for _, file := range resp.File {
+		document, err := libopenapi.NewDocument([]byte(file.GetContent()))
+		require.NoError(t, err)
+		model, errs := document.BuildV3Model()
+		require.Empty(t, errs)
+		models[file.GetName()] = &model.Model
+	}
+	require.Contains(t, models, "public.openapi.yaml")
+	require.Contains(t, models, "partner.openapi.yaml")
+	require.Contains(t, models, "internal/parcels.openapi.yaml")
+
+	tests := []struct {
+		name       string
+		title      string
+		servers    []string
+		paths      []string
+		properties []string
+	}{
+		{
+			name:       "public.openapi.yaml",
+			title:      "Parcels",
+			servers:    []string{"https://api.example.com"},
+			paths:      []string{"/v1/parcels/{id}"},
+			properties: []string{"id", "status"},
+		},
+		{
+			name:       "partner.openapi.yaml",
+			title:      "Parcels for partners",
+			servers:    []string{"https://partners.example.com"},
+			paths:      []string{"/v1/parcels/{id}", "/v1/parcels:ship"},
+			properties: []string{"id", "status", "price", "depot"},
+		},
+		{
+			name:       "internal/parcels.openapi.yaml",
+			title:      "Parcels and depots",
+			paths:      []string{"/v1/parcels/{id}", "/v1/parcels:ship", "/v1/parcels/{id}:reroute", "/v1/depots/{id}:close"},
+			properties: []string{"id", "status", "price", "depot"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := models[tt.name]
+			assert.Equal(t, tt.title, model.Info.Title)
+			assert.Equal(t, "v1", model.Info.Version)
+			assert.ElementsMatch(t, tt.servers, lo.Map(model.Servers, func(s *v3.Server, _ int) string { return s.URL }))
+			assert.ElementsMatch(t, tt.paths, slices.Collect(model.Paths.PathItems.KeysFromOldest()))
+
+			parcel, ok := model.Components.Schemas.Get("variants.Parcel")
+			require.True(t, ok)
+			assert.Equal(t, tt.properties, slices.Collect(parcel.Schema().Properties.KeysFromOldest()))
+		})
+	}
+}
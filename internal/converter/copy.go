@@ -8,7 +8,6 @@ import (
 	"strconv"
 	"strings"
 
-	openapiv3 "github.com/google/gnostic-models/openapiv3"
 	"github.com/lmittmann/tint"
 	"github.com/pb33f/libopenapi"
 	"github.com/pb33f/libopenapi/datamodel/high/base"
@@ -81,45 +80,56 @@ func ConvertV1(gen *protogen.Plugin, cfg options.Config) error {
 		}
 	}
 
-	spec := assert.Must1(newSpec())
-
 	outFiles := map[string]*v3.Document{}
-	for _, fileDesc := range req.GetProtoFile() {
-		if _, ok := genFiles[fileDesc.GetName()]; !ok {
-			continue
-		}
+	// The operations of HTTP methods without a path item field, for every document.
+	additional := map[*v3.Document]*util.AdditionalOperations{}
+	// Every variant is generated from the same descriptors, with its own options.
+	for _, opts := range opts.VariantOptions() {
+		spec := assert.Must1(newSpec())
+		for _, fileDesc := range req.GetProtoFile() {
+			if _, ok := genFiles[fileDesc.GetName()]; !ok {
+				continue
+			}
 
-		slog.Debug("generating file", slog.String("name", fileDesc.GetName()))
+			slog.Debug("generating file", slog.String("name", fileDesc.GetName()))
 
-		fd, err := resolver.FindFileByPath(fileDesc.GetName())
-		if err != nil {
-			slog.Error("error loading file", slog.Any("error", err))
-			return err
-		}
+			fd, err := resolver.FindFileByPath(fileDesc.GetName())
+			if err != nil {
+				slog.Error("error loading file", slog.Any("error", err))
+				return err
+			}
 
-		// Create a per-file openapi spec if we're not merging all into one
-		if opts.Path == "" {
-			spec = assert.Must1(newSpec())
-			spec.Info.Title = string(fd.FullName())
-			spec.Info.Description = util.FormatComments(fd.SourceLocations().ByDescriptor(fd))
-		}
+			// Create a per-file openapi spec if we're not merging all into one
+			if opts.Path == "" {
+				spec = assert.Must1(newSpec())
+				spec.Info.Title = string(fd.FullName())
+				spec.Info.Description = util.FormatComments(opts, fd.SourceLocations().ByDescriptor(fd))
+			}
 
-		assert.Must(appendToSpec(opts, spec, fd))
+			if additional[spec] == nil {
+				additional[spec] = util.NewAdditionalOperations()
+			}
+			assert.Must(appendToSpec(opts, spec, additional[spec], fd))
 
-		if opts.Path == "" {
-			name := fileDesc.GetName()
-			filename := strings.TrimSuffix(name, filepath.Ext(name)) + ".openapi." + opts.Format
-			outFiles[filename] = spec
-		}
+			if opts.Path == "" {
+				name := fileDesc.GetName()
+				filename := strings.TrimSuffix(name, filepath.Ext(name)) + ".openapi." + opts.Format
+				outFiles[filename] = spec
+			}
 
-		spec.Tags = mergeTags(spec.Tags)
-	}
+			spec.Tags = mergeTags(spec.Tags)
+		}
 
-	if opts.Path != "" {
-		outFiles[opts.Path] = spec
+		if opts.Path != "" {
+			applyVariant(opts, spec)
+			outFiles[opts.Path] = spec
+		}
 	}
 
 	for path, doc := range outFiles {
+		googleapi.LinkOperations(doc, additional[doc])
+		reportUnsecured(opts, path, doc, additional[doc])
+		additional[doc].Render(doc)
 		content := assert.Must1(specToFile(opts, doc))
 
 		gg := gen.NewGeneratedFile(path, "")
@@ -129,19 +139,59 @@ func ConvertV1(gen *protogen.Plugin, cfg options.Config) error {
 	return nil
 }
 
-func mergeOperationV2(existing *v3.Operation, srv *generator.Service) {
-	if srv == nil || existing == nil {
+// mergeOperationV2 applies the openapi.v3.service option of a service to an operation of one of its methods.
+// What the method sets itself, in its openapi.v3.operation option or as deprecated = false, wins.
+func mergeOperationV2(existing *v3.Operation, srv *generator.Service, method protoreflect.MethodDescriptor) {
+	if existing == nil {
+		return
+	}
+	inheritSecurity(existing, srv, method)
+	if srv == nil {
 		return
 	}
+	methodOp := gnostic.GetOperation(method)
 
 	existing.Tags = lo.Uniq(append(existing.Tags, srv.Tags...))
-	securityList := lo.Map(srv.Security, func(item *openapiv3.NamedStringArray, index int) *openapiv3.SecurityRequirement {
-		return &openapiv3.SecurityRequirement{AdditionalProperties: []*openapiv3.NamedStringArray{item}}
-	})
-	existing.Security = append(existing.Security, gnostic.ToSecurityRequirements(securityList)...)
 	existing.Servers = append(existing.Servers, gnostic.ToServers(srv.Servers)...)
-	existing.Parameters = append(existing.Parameters, gnostic.ToParameter(srv.Parameters)...)
-	existing.Parameters = lo.UniqBy(existing.Parameters, func(item *v3.Parameter) string { return item.Name + item.In })
+	if len(srv.Parameters) > 0 {
+		existing.Parameters = append(existing.Parameters, gnostic.ToParameter(srv.Parameters)...)
+		existing.Parameters = lo.UniqBy(existing.Parameters, func(item *v3.Parameter) string {
+			if item.Extensions != nil {
+				if ref, ok := item.Extensions.Get("$ref"); ok {
+					return ref.Value
+				}
+			}
+			return item.Name + item.In
+		})
+	}
+
+	if responses := gnostic.ToResponses(srv.Responses); responses != nil {
+		if existing.Responses == nil {
+			existing.Responses = &v3.Responses{Codes: orderedmap.New[string, *v3.Response]()}
+		}
+		methodCodes := map[string]struct{}{}
+		for _, response := range methodOp.GetResponses().GetResponseOrReference() {
+			methodCodes[response.GetName()] = struct{}{}
+		}
+		for pair := responses.Codes.First(); pair != nil; pair = pair.Next() {
+			if _, ok := methodCodes[pair.Key()]; !ok {
+				existing.Responses.Codes.Set(pair.Key(), pair.Value())
+			}
+		}
+		if responses.Default != nil && methodOp.GetResponses().GetDefault() == nil {
+			existing.Responses.Default = responses.Default
+		}
+	}
+
+	if srv.Deprecated && util.IsMethodDeprecated(method) == nil {
+		existing.Deprecated = util.BoolPtr(true)
+	}
+	if existing.ExternalDocs == nil {
+		existing.ExternalDocs = gnostic.ToExternalDocs(srv.ExternalDocs)
+	}
+	if srv.OperationIdPrefix != "" && methodOp.GetOperationId() == "" {
+		existing.OperationId = srv.OperationIdPrefix + existing.OperationId
+	}
 
 	ext := gnostic.ToExtensions(srv.Extensions)
 	if ext == nil {
@@ -152,7 +202,9 @@ func mergeOperationV2(existing *v3.Operation, srv *generator.Service) {
 		existing.Extensions = ext
 	} else {
 		for pair := ext.First(); pair != nil; pair = pair.Next() {
-			existing.Extensions.Set(pair.Key(), pair.Value())
+			if _, ok := existing.Extensions.Get(pair.Key()); !ok {
+				existing.Extensions.Set(pair.Key(), pair.Value())
+			}
 		}
 	}
 }
@@ -190,6 +242,9 @@ func mergePathItemsV1(existing, new *v3.PathItem) *v3.PathItem {
 	existing.Parameters = append(existing.Parameters, new.Parameters...)
 
 	// Merge extensions
+	if new.Extensions != nil && existing.Extensions == nil {
+		existing.Extensions = orderedmap.New[string, *yaml.Node]()
+	}
 	for pair := new.Extensions.First(); pair != nil; pair = pair.Next() {
 		if _, ok := existing.Extensions.Get(pair.Key()); !ok {
 			existing.Extensions.Set(pair.Key(), pair.Value())
@@ -200,11 +255,11 @@ func mergePathItemsV1(existing, new *v3.PathItem) *v3.PathItem {
 
 var _ = addPathItemsFromFile
 
-func addPathItemsFromFileV1(opts options.Options, fd protoreflect.FileDescriptor, paths *v3.Paths) error {
+func addPathItemsFromFileV1(opts options.Options, fd protoreflect.FileDescriptor, paths *v3.Paths, additional *util.AdditionalOperations) error {
 	services := fd.Services()
 	for i := 0; i < services.Len(); i++ {
 		service := services.Get(i)
-		if !opts.HasService(service.FullName()) {
+		if !hasService(opts, service) {
 			continue
 		}
 
@@ -212,33 +267,47 @@ func addPathItemsFromFileV1(opts options.Options, fd protoreflect.FileDescriptor
 		methods := service.Methods()
 		for j := 0; j < methods.Len(); j++ {
 			method := methods.Get(j)
-			pathItems := googleapi.MakePathItems(opts, method)
+			if !hasMethod(opts, method) {
+				continue
+			}
+			pathItems, methodOperations := googleapi.MakePathItems(opts, method)
 
 			// Helper function to update or set path items
-			addPathItem := func(path string, newItem *v3.PathItem) {
-				path = util.MakePath(opts, path)
+			addPathItem := func(path string, newItem *v3.PathItem, custom *orderedmap.Map[string, *v3.Operation]) {
+				path = util.MakePath(opts, srv.GetPathPrefix()+path)
+				if !opts.HasPath(path) {
+					return
+				}
+				// The operations of the path item that are already there got the options of their own service.
+				// Pagination links to the operation, so it comes once the service has prefixed its id.
+				applyOptions := func(op *v3.Operation) {
+					mergeOperationV2(op, srv, method)
+					googleapi.ApplyPagination(opts, method, op)
+					googleapi.ApplyOperationInfo(opts, method, op)
+				}
+				for op := range newItem.GetOperations().ValuesFromOldest() {
+					applyOptions(op)
+				}
+				for pair := custom.First(); pair != nil; pair = pair.Next() {
+					applyOptions(pair.Value())
+					additional.Set(path, pair.Key(), pair.Value())
+				}
 				if existing, ok := paths.PathItems.Get(path); ok {
 					newItem = mergePathItemsV1(existing, newItem)
 				}
-
-				mergeOperationV2(newItem.Get, srv)
-				mergeOperationV2(newItem.Put, srv)
-				mergeOperationV2(newItem.Post, srv)
-				mergeOperationV2(newItem.Delete, srv)
-				mergeOperationV2(newItem.Patch, srv)
 				paths.PathItems.Set(path, newItem)
 			}
 
 			// Update path items from google.api annotations
 			for pair := pathItems.First(); pair != nil; pair = pair.Next() {
-				item := gnostic.PathItemWithMethodAnnotations(pair.Value(), method)
-				addPathItem(pair.Key(), item)
+				gnostic.OperationsWithMethodAnnotations(methodOperations.Operations(pair.Key(), pair.Value()), method)
+				addPathItem(pair.Key(), pair.Value(), methodOperations.Get(pair.Key()))
 			}
 
 			// Default to ConnectRPC/gRPC path if no google.api annotations
 			if pathItems == nil || pathItems.Len() == 0 {
 				path := "/" + string(service.FullName()) + "/" + string(method.Name())
-				addPathItem(path, methodToPathItem(opts, method))
+				addPathItem(path, methodToPathItem(opts, method), nil)
 			}
 		}
 	}
@@ -247,15 +316,14 @@ func addPathItemsFromFileV1(opts options.Options, fd protoreflect.FileDescriptor
 }
 
 func methodToOperation(opts options.Options, method protoreflect.MethodDescriptor, returnGet bool) *v3.Operation {
-	fd := method.ParentFile()
 	service := method.Parent().(protoreflect.ServiceDescriptor)
-	loc := fd.SourceLocations().ByDescriptor(method)
+	summary, description := util.MethodSummary(opts, method)
 	op := &v3.Operation{
-		Summary:     string(method.Name()),
+		Summary:     summary,
 		OperationId: string(method.FullName()),
 		Deprecated:  util.IsMethodDeprecated(method),
 		Tags:        []string{string(service.FullName())},
-		Description: util.FormatComments(loc),
+		Description: description,
 	}
 
 	isStreaming := method.IsStreamingClient() || method.IsStreamingServer()
@@ -437,11 +505,13 @@ func setComponents(hasMethods bool, components *v3.Components) {
 func enumToSchemaV1(state *State, tt protoreflect.EnumDescriptor) (string, *base.Schema) {
 	slog.Debug("enumToSchema", slog.Any("descriptor", tt.FullName()))
 	children := make([]*yaml.Node, 0)
-	values := tt.Values()
-	desc := util.FormatComments(tt.ParentFile().SourceLocations().ByDescriptor(tt))
-	for i := 0; i < values.Len(); i++ {
-		value := values.Get(i)
-		comment := util.FormatComments(tt.ParentFile().SourceLocations().ByDescriptor(value))
+	values := util.EnumValues(tt, state.Opts.OmitEnumUnspecified)
+	desc := util.FormatComments(state.Opts, tt.ParentFile().SourceLocations().ByDescriptor(tt))
+	if desc != "" && len(values) > 0 {
+		desc += "\n\n"
+	}
+	for _, value := range values {
+		comment := util.EnumValueDescription(state.Opts, value)
 		if comment != "" {
 			desc += fmt.Sprintf("- %d, %s: %s\n", value.Number(), value.Name(), comment)
 		} else {
@@ -464,9 +534,12 @@ func enumToSchemaV1(state *State, tt protoreflect.EnumDescriptor) (string, *base
 		Description: desc,
 		Type:        []string{"string"},
 		Enum:        children,
-		Default:     children[0],
 	}
-	return string(tt.FullName()), s
+	// The zero value is the default unless it isn't allowed.
+	if len(children) > 0 && !state.Opts.WithoutEnumDefault && !state.Opts.OmitEnumUnspecified {
+		s.Default = children[0]
+	}
+	return string(tt.FullName()), schemaWithEnumValueAnnotations(state.Opts, s, values)
 }
 
 func setResponse(rsp *v3.Response) {
@@ -0,0 +1,78 @@
+package converter
+
+import (
+	"strconv"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"github.com/pb33f/libopenapi/utils"
+	"github.com/pubgo/protoc-gen-openapi/internal/converter/options"
+	"github.com/pubgo/protoc-gen-openapi/internal/converter/util"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"gopkg.in/yaml.v3"
+)
+
+// schemaWithEnumValueAnnotations documents the label, description and deprecation of each value of an enum.
+// With opts.EnumOneOf the values become a oneOf of const schemas, otherwise the x-enum-varnames and
+// x-enum-descriptions lists follow the order of enum and x-enum-deprecated lists the deprecated values. The
+// extensions are only added when a value has the openapi.v3.enum_value option or is deprecated, or when
+// opts.TrimEnumPrefix gives the values labels of their own.
+func schemaWithEnumValueAnnotations(opts options.Options, s *base.Schema, values []protoreflect.EnumValueDescriptor) *base.Schema {
+	if opts.EnumOneOf {
+		for _, value := range values {
+			consts := []*yaml.Node{utils.CreateStringNode(string(value.Name()))}
+			if opts.IncludeNumberEnumValues {
+				consts = append(consts, utils.CreateIntNode(strconv.FormatInt(int64(value.Number()), 10)))
+			}
+			for _, c := range consts {
+				option := &base.Schema{
+					Const:       c,
+					Title:       util.EnumValueLabel(opts, value),
+					Description: util.EnumValueDescription(opts, value),
+				}
+				if util.IsEnumValueDeprecated(value) {
+					option.Deprecated = util.BoolPtr(true)
+				}
+				s.OneOf = append(s.OneOf, base.CreateSchemaProxy(option))
+			}
+		}
+		s.Enum = nil
+		return s
+	}
+
+	annotated := opts.TrimEnumPrefix
+	varnames := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+	descriptions := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+	deprecated := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+	for _, value := range values {
+		if util.GetEnumValueOptions(value) != nil {
+			annotated = true
+		}
+		if util.IsEnumValueDeprecated(value) {
+			deprecated.Content = append(deprecated.Content, utils.CreateStringNode(string(value.Name())))
+		}
+		// Numbers, when included, follow each name in enum.
+		repeat := 1
+		if opts.IncludeNumberEnumValues {
+			repeat = 2
+		}
+		for j := 0; j < repeat; j++ {
+			varnames.Content = append(varnames.Content, utils.CreateStringNode(util.EnumValueLabel(opts, value)))
+			descriptions.Content = append(descriptions.Content, utils.CreateStringNode(util.EnumValueDescription(opts, value)))
+		}
+	}
+	if !annotated && len(deprecated.Content) == 0 {
+		return s
+	}
+	if s.Extensions == nil {
+		s.Extensions = orderedmap.New[string, *yaml.Node]()
+	}
+	if annotated {
+		s.Extensions.Set("x-enum-varnames", varnames)
+		s.Extensions.Set("x-enum-descriptions", descriptions)
+	}
+	if len(deprecated.Content) > 0 {
+		s.Extensions.Set("x-enum-deprecated", deprecated)
+	}
+	return s
+}
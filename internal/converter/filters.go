@@ -0,0 +1,59 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/pubgo/protoc-gen-openapi/internal/converter/googleapi"
+	"github.com/pubgo/protoc-gen-openapi/internal/converter/options"
+	"github.com/pubgo/protoc-gen-openapi/internal/converter/util"
+)
+
+// hasService reports if a service is documented: it is one of opts.Services, it is visible and, when there are
+// filters, one of its methods is documented.
+func hasService(opts options.Options, service protoreflect.ServiceDescriptor) bool {
+	if !opts.HasService(service.FullName()) || !util.IsVisible(opts, service) {
+		return false
+	}
+	if len(opts.Filters) == 0 {
+		return true
+	}
+	methods := service.Methods()
+	for i := 0; i < methods.Len(); i++ {
+		if hasMethod(opts, methods.Get(i)) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasMethod reports if a method of a documented service is documented: it is visible, the name filters keep it
+// and the path filters keep one of its HTTP paths.
+func hasMethod(opts options.Options, method protoreflect.MethodDescriptor) bool {
+	if !util.IsVisible(opts, method) || !opts.HasMethod(method) {
+		return false
+	}
+	if !opts.HasPathFilters() {
+		return true
+	}
+	for _, path := range methodPaths(opts, method) {
+		if opts.HasPath(path) {
+			return true
+		}
+	}
+	return false
+}
+
+// methodPaths returns the HTTP paths a method is documented at, from its google.api.http option or else the
+// ConnectRPC path, with the prefixes of the options and of its service.
+func methodPaths(opts options.Options, method protoreflect.MethodDescriptor) []string {
+	service := method.Parent().(protoreflect.ServiceDescriptor)
+	prefix := googleapi.GetSrvOptions(opts, service).GetPathPrefix()
+	var paths []string
+	for _, path := range googleapi.MethodPaths(opts, method) {
+		paths = append(paths, util.MakePath(opts, prefix+path))
+	}
+	if len(paths) == 0 {
+		paths = append(paths, util.MakePath(opts, prefix+"/"+string(service.FullName())+"/"+string(method.Name())))
+	}
+	return paths
+}
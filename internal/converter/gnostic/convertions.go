@@ -1,15 +1,22 @@
 package gnostic
 
 import (
+	"log/slog"
+	"math"
 	"strconv"
+	"strings"
 
 	goa3 "github.com/google/gnostic/openapiv3"
 	base "github.com/pb33f/libopenapi/datamodel/high/base"
 	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/datamodel/low"
+	lowbase "github.com/pb33f/libopenapi/datamodel/low/base"
+	lowv3 "github.com/pb33f/libopenapi/datamodel/low/v3"
 	"github.com/pb33f/libopenapi/orderedmap"
 	"github.com/pb33f/libopenapi/utils"
 	"github.com/pubgo/protoc-gen-openapi/internal/converter/options"
 	"github.com/pubgo/protoc-gen-openapi/internal/converter/util"
+	"google.golang.org/protobuf/encoding/protojson"
 	"gopkg.in/yaml.v3"
 )
 
@@ -32,6 +39,7 @@ func toServer(server *goa3.Server) *v3.Server {
 		URL:         server.Url,
 		Description: server.Description,
 		Variables:   toVariables(server.Variables),
+		Extensions:  toExtensions(server.SpecificationExtension),
 	}
 }
 
@@ -42,9 +50,10 @@ func toVariables(variables *goa3.ServerVariables) *orderedmap.Map[string, *v3.Se
 	vars := orderedmap.New[string, *v3.ServerVariable]()
 	for _, prop := range variables.AdditionalProperties {
 		vars.Store(prop.Name, &v3.ServerVariable{
-			Enum:        prop.Value.Enum,
-			Default:     prop.Value.Default,
-			Description: prop.Value.Description,
+			Enum:        prop.GetValue().GetEnum(),
+			Default:     prop.GetValue().GetDefault(),
+			Description: prop.GetValue().GetDescription(),
+			Extensions:  toExtensions(prop.GetValue().GetSpecificationExtension()),
 		})
 	}
 	return vars
@@ -55,7 +64,7 @@ func toSecurityRequirements(securityReq []*goa3.SecurityRequirement) []*base.Sec
 	for i, req := range securityReq {
 		reqs := orderedmap.New[string, []string]()
 		for _, prop := range req.AdditionalProperties {
-			reqs.Set(prop.Name, prop.Value.Value)
+			reqs.Set(prop.Name, prop.GetValue().GetValue())
 		}
 
 		result[i] = &base.SecurityRequirement{
@@ -80,8 +89,8 @@ func appendComponents(spec *v3.Document, c *goa3.Components) {
 		Headers:         toHeaders(c.Headers),
 		Links:           toLinks(c.Links),
 		Callbacks:       toCallbacks(c.Callbacks),
-		Extensions:      toExtensions(c.SpecificationExtension),
 	})
+	spec.Components.Extensions = mergeExtensions(spec.Components.Extensions, c.SpecificationExtension)
 }
 
 func toParametersMap(params *goa3.ParametersOrReferences) *orderedmap.Map[string, *v3.Parameter] {
@@ -95,18 +104,29 @@ func toParametersMap(params *goa3.ParametersOrReferences) *orderedmap.Map[string
 func toRequestBodiesMap(bodies *goa3.RequestBodiesOrReferences) *orderedmap.Map[string, *v3.RequestBody] {
 	m := orderedmap.New[string, *v3.RequestBody]()
 	for _, item := range bodies.GetAdditionalProperties() {
-		m.Set(item.Name, toRequestBody(item.GetValue().GetRequestBody()))
+		m.Set(item.Name, toRequestBody(item.GetValue()))
 	}
 	return m
 }
 
-func toRequestBody(rbody *goa3.RequestBody) *v3.RequestBody {
-	return &v3.RequestBody{
+func toRequestBody(r *goa3.RequestBodyOrReference) *v3.RequestBody {
+	if ref := r.GetReference(); ref != nil {
+		l := &lowv3.RequestBody{Reference: lowReference(ref.XRef)}
+		return v3.NewRequestBody(l)
+	}
+	rbody := r.GetRequestBody()
+	if rbody == nil {
+		return nil
+	}
+	body := &v3.RequestBody{
 		Description: rbody.Description,
 		Content:     toMediaTypes(rbody.GetContent()),
-		Required:    &rbody.Required,
 		Extensions:  toExtensions(rbody.SpecificationExtension),
 	}
+	if rbody.Required {
+		body.Required = &rbody.Required
+	}
+	return body
 }
 
 func toResponsesMap(resps *goa3.ResponsesOrReferences) *orderedmap.Map[string, *v3.Response] {
@@ -128,15 +148,23 @@ func toSecuritySchemes(s *goa3.SecuritySchemesOrReferences) *orderedmap.Map[stri
 
 	secSchemas := orderedmap.New[string, *v3.SecurityScheme]()
 	for _, addProp := range s.AdditionalProperties {
+		if ref := addProp.Value.GetReference(); ref != nil {
+			l := &lowv3.SecurityScheme{Reference: lowReference(ref.XRef)}
+			secSchemas.Set(addProp.Name, v3.NewSecurityScheme(l))
+			continue
+		}
 		secScheme := addProp.Value.GetSecurityScheme()
 		if secScheme != nil {
 			scheme := &v3.SecurityScheme{
-				Type: secScheme.Type,
+				Type:        secScheme.Type,
+				Description: secScheme.Description,
+				Extensions:  toExtensions(secScheme.SpecificationExtension),
 			}
 			switch secScheme.Type {
 			case "mutualTLS":
 			case "http":
 				scheme.Scheme = secScheme.Scheme
+				scheme.BearerFormat = secScheme.BearerFormat
 			case "apiKey":
 				scheme.Name = secScheme.Name
 				scheme.In = secScheme.In
@@ -226,18 +254,10 @@ func toTags(tags []*goa3.Tag) []*base.Tag {
 
 	result := make([]*base.Tag, len(tags))
 	for i, tag := range tags {
-		var extDoc *base.ExternalDoc
-		if tag.ExternalDocs != nil {
-			extDoc = &base.ExternalDoc{
-				Description: tag.Description,
-				URL:         tag.ExternalDocs.Url,
-				Extensions:  toExtensions(tag.ExternalDocs.SpecificationExtension),
-			}
-		}
 		result[i] = &base.Tag{
 			Name:         tag.Name,
 			Description:  tag.Description,
-			ExternalDocs: extDoc,
+			ExternalDocs: toExternalDocs(tag.ExternalDocs),
 			Extensions:   toExtensions(tag.SpecificationExtension),
 		}
 	}
@@ -285,19 +305,59 @@ func toDefault(dt *goa3.DefaultType) *yaml.Node {
 	}
 	switch dt.GetOneof().(type) {
 	case *goa3.DefaultType_Number:
-		return utils.CreateStringNode(strconv.FormatFloat(dt.GetNumber(), 'f', -1, 64))
+		// Whole numbers are written without a fraction so they stay valid defaults of integer schemas.
+		number := strconv.FormatFloat(dt.GetNumber(), 'f', -1, 64)
+		if dt.GetNumber() == math.Trunc(dt.GetNumber()) {
+			return utils.CreateIntNode(number)
+		}
+		return utils.CreateFloatNode(number)
 	case *goa3.DefaultType_String_:
 		return utils.CreateStringNode(dt.GetString_())
 	case *goa3.DefaultType_Boolean:
-		if dt.GetBoolean() {
-			return utils.CreateStringNode("true")
-		}
-		return utils.CreateStringNode("false")
+		return utils.CreateBoolNode(strconv.FormatBool(dt.GetBoolean()))
 	default:
 		return nil
 	}
 }
 
+// toAny returns the value of an example or extension, written either as YAML or as a packed protobuf message
+// that is rendered like its JSON mapping. Packed messages must be registered with the protobuf runtime, which
+// is the case for the well-known types.
+func toAny(v *goa3.Any) *yaml.Node {
+	if v == nil || v.Yaml == "" && v.Value == nil {
+		return nil
+	}
+	if v.Yaml != "" {
+		return v.ToRawInfo()
+	}
+	msg, err := v.Value.UnmarshalNew()
+	if err != nil {
+		slog.Warn("unable to unmarshal pb.any example", slog.Any("error", err))
+		return nil
+	}
+	b, err := protojson.Marshal(msg)
+	if err != nil {
+		slog.Warn("unable to marshal pb.any example", slog.Any("error", err))
+		return nil
+	}
+	var node yaml.Node
+	if err := yaml.Unmarshal(b, &node); err != nil || len(node.Content) == 0 {
+		return nil
+	}
+	return node.Content[0]
+}
+
+func toDiscriminator(d *goa3.Discriminator) *base.Discriminator {
+	discriminator := &base.Discriminator{PropertyName: d.GetPropertyName()}
+	if props := d.GetMapping().GetAdditionalProperties(); len(props) > 0 {
+		discriminator.Mapping = orderedmap.New[string, string]()
+		for _, prop := range props {
+			discriminator.Mapping.Set(prop.Name, prop.Value)
+		}
+	}
+	return discriminator
+}
+
 func toAdditionalPropertiesItem(item *goa3.AdditionalPropertiesItem) *base.DynamicValue[*base.SchemaProxy, bool] {
 	switch v := item.Oneof.(type) {
 	case *goa3.AdditionalPropertiesItem_SchemaOrReference:
@@ -314,7 +374,7 @@ func toExtensions(items []*goa3.NamedAny) *orderedmap.Map[string, *yaml.Node] {
 	}
 	extensions := orderedmap.New[string, *yaml.Node]()
 	for _, namedAny := range items {
-		extensions.Set(namedAny.Name, namedAny.Value.ToRawInfo())
+		extensions.Set(namedAny.Name, toAny(namedAny.Value))
 	}
 	return extensions
 }
@@ -325,13 +385,16 @@ func toEncodings(enc *goa3.Encodings) *orderedmap.Map[string, *v3.Encoding] {
 	}
 	encodings := orderedmap.New[string, *v3.Encoding]()
 	for _, encoding := range enc.GetAdditionalProperties() {
-		encodings.Set(encoding.Name, &v3.Encoding{
+		e := &v3.Encoding{
 			ContentType:   encoding.Value.ContentType,
 			Headers:       toHeaders(encoding.Value.Headers),
 			Style:         encoding.Value.Style,
-			Explode:       &encoding.Value.Explode,
 			AllowReserved: encoding.Value.AllowReserved,
-		})
+		}
+		if encoding.Value.Explode {
+			e.Explode = &encoding.Value.Explode
+		}
+		encodings.Set(encoding.Name, e)
 	}
 	return encodings
 }
@@ -342,11 +405,19 @@ func toExamples(exes *goa3.ExamplesOrReferences) *orderedmap.Map[string, *base.E
 	}
 	examples := orderedmap.New[string, *base.Example]()
 	for _, item := range exes.GetAdditionalProperties() {
+		if ref := item.GetValue().GetReference(); ref != nil {
+			l := &lowbase.Example{Reference: lowReference(ref.XRef)}
+			examples.Set(item.Name, base.NewExample(l))
+			continue
+		}
 		example := item.GetValue().GetExample()
+		if example == nil {
+			continue
+		}
 		examples.Set(item.Name, &base.Example{
 			Summary:       example.Summary,
 			Description:   example.Description,
-			Value:         example.Value.ToRawInfo(),
+			Value:         toAny(example.Value),
 			ExternalValue: example.ExternalValue,
 			Extensions:    toExtensions(example.SpecificationExtension),
 		})
@@ -366,9 +437,7 @@ func toMediaTypes(items *goa3.MediaTypes) *orderedmap.Map[string, *v3.MediaType]
 			Encoding:   toEncodings(item.Value.GetEncoding()),
 			Extensions: toExtensions(item.Value.GetSpecificationExtension()),
 		}
-		if val := item.GetValue().Example; val != nil {
-			mt.Example = val.ToRawInfo()
-		}
+		mt.Example = toAny(item.GetValue().Example)
 		content.Set(item.Name, mt)
 	}
 	return content
@@ -381,15 +450,17 @@ func toHeaders(v *goa3.HeadersOrReferences) *orderedmap.Map[string, *v3.Header]
 	headers := orderedmap.New[string, *v3.Header]()
 	for _, headerVal := range v.GetAdditionalProperties() {
 		if ref := headerVal.Value.GetReference(); ref != nil {
+			if strings.HasPrefix(ref.XRef, "#/components/headers/") {
+				l := &lowv3.Header{Reference: lowReference(ref.XRef)}
+				headers.Set(headerVal.Name, v3.NewHeader(l))
+				continue
+			}
+			// Headers documented with the schema of a component other than a header.
 			headers.Set(headerVal.Name, &v3.Header{
 				Description: ref.Description,
 				Schema:      base.CreateSchemaProxyRef(ref.XRef),
 			})
 		} else if header := headerVal.Value.GetHeader(); header != nil {
-			var exampleRawInfo *yaml.Node
-			if header.Example != nil {
-				exampleRawInfo = header.Example.ToRawInfo()
-			}
 			headers.Set(headerVal.Name, &v3.Header{
 				Description:     header.Description,
 				Required:        header.Required,
@@ -399,7 +470,7 @@ func toHeaders(v *goa3.HeadersOrReferences) *orderedmap.Map[string, *v3.Header]
 				Explode:         header.Explode,
 				AllowReserved:   header.AllowReserved,
 				Schema:          toSchemaOrReference(header.Schema),
-				Example:         exampleRawInfo,
+				Example:         toAny(header.Example),
 				Examples:        toExamples(header.GetExamples()),
 				Content:         toMediaTypes(header.Content),
 				Extensions:      toExtensions(header.GetSpecificationExtension()),
@@ -433,6 +504,11 @@ func toResponse(r *goa3.ResponseOrReference) *v3.Response {
 		return nil
 	}
 	if v := r.GetReference(); v != nil {
+		if strings.HasPrefix(v.XRef, "#/components/responses/") {
+			l := &lowv3.Response{Reference: lowReference(v.XRef)}
+			return v3.NewResponse(l)
+		}
+		// A reference to a schema documents the JSON body of the response.
 		return &v3.Response{
 			Description: v.Description,
 			Headers:     nil,
@@ -469,17 +545,33 @@ func toLinks(ls *goa3.LinksOrReferences) *orderedmap.Map[string, *v3.Link] {
 	}
 	links := orderedmap.New[string, *v3.Link]()
 	for _, item := range ls.AdditionalProperties {
+		if ref := item.Value.GetReference(); ref != nil {
+			l := &lowv3.Link{Reference: lowReference(ref.XRef)}
+			links.Set(item.Name, v3.NewLink(l))
+			continue
+		}
 		link := item.Value.GetLink()
-		params := orderedmap.New[string, string]()
-		for _, param := range link.Parameters.GetExpression().GetAdditionalProperties() {
-			params.Set(param.Name, param.Value.Yaml)
+		if link == nil {
+			continue
+		}
+		var params *orderedmap.Map[string, string]
+		if exprs := link.Parameters.GetExpression().GetAdditionalProperties(); len(exprs) > 0 {
+			params = orderedmap.New[string, string]()
+			for _, param := range exprs {
+				if expr := toAny(param.Value); expr != nil {
+					params.Set(param.Name, expr.Value)
+				}
+			}
+		}
+		var requestBody string
+		if expr := toAny(link.GetRequestBody().GetAny()); expr != nil {
+			requestBody = expr.Value
 		}
-
 		links.Set(item.Name, &v3.Link{
 			OperationRef: link.OperationRef,
 			OperationId:  link.OperationId,
 			Parameters:   params,
-			RequestBody:  link.RequestBody.String(),
+			RequestBody:  requestBody,
 			Description:  link.Description,
 			Server:       toServer(link.Server),
 			Extensions:   toExtensions(link.SpecificationExtension),
@@ -494,25 +586,15 @@ func toCallbacks(cbs *goa3.CallbacksOrReferences) *orderedmap.Map[string, *v3.Ca
 	}
 	callbacks := orderedmap.New[string, *v3.Callback]()
 	for _, item := range cbs.GetAdditionalProperties() {
+		if ref := item.Value.GetReference(); ref != nil {
+			l := &lowv3.Callback{Reference: lowReference(ref.XRef)}
+			callbacks.Set(item.Name, v3.NewCallback(l))
+			continue
+		}
 		callback := item.Value.GetCallback()
 		expressions := orderedmap.New[string, *v3.PathItem]()
 		for _, item := range callback.GetPath() {
-			expr := item.Value
-			expressions.Set(item.Name, &v3.PathItem{
-				Description: expr.Description,
-				Summary:     expr.Summary,
-				Get:         toOperation(expr.Get),
-				Put:         toOperation(expr.Put),
-				Post:        toOperation(expr.Post),
-				Delete:      toOperation(expr.Delete),
-				Options:     toOperation(expr.Options),
-				Head:        toOperation(expr.Head),
-				Patch:       toOperation(expr.Patch),
-				Trace:       toOperation(expr.Trace),
-				Servers:     toServers(expr.Servers),
-				Parameters:  toParameters(expr.Parameters),
-				Extensions:  toExtensions(expr.SpecificationExtension),
-			})
+			expressions.Set(item.Name, toPathItem(item.Value))
 		}
 		callbacks.Set(item.Name, &v3.Callback{
 			Expression: expressions,
@@ -522,25 +604,51 @@ func toCallbacks(cbs *goa3.CallbacksOrReferences) *orderedmap.Map[string, *v3.Ca
 	return callbacks
 }
 
+func toPathItem(item *goa3.PathItem) *v3.PathItem {
+	if item == nil {
+		return nil
+	}
+	return &v3.PathItem{
+		Description: item.Description,
+		Summary:     item.Summary,
+		Get:         toOperation(item.Get),
+		Put:         toOperation(item.Put),
+		Post:        toOperation(item.Post),
+		Delete:      toOperation(item.Delete),
+		Options:     toOperation(item.Options),
+		Head:        toOperation(item.Head),
+		Patch:       toOperation(item.Patch),
+		Trace:       toOperation(item.Trace),
+		Servers:     toServers(item.Servers),
+		Parameters:  toParameters(item.Parameters),
+		Extensions:  toExtensions(item.SpecificationExtension),
+	}
+}
+
 func toOperation(op *goa3.Operation) *v3.Operation {
 	if op == nil {
 		return nil
 	}
-	return &v3.Operation{
+	oper := &v3.Operation{
 		Tags:         op.Tags,
 		Summary:      op.Summary,
 		Description:  op.Description,
 		ExternalDocs: toExternalDocs(op.ExternalDocs),
 		OperationId:  op.OperationId,
 		Parameters:   toParameters(op.Parameters),
-		RequestBody:  nil,
+		RequestBody:  toRequestBody(op.RequestBody),
 		Responses:    toResponses(op.GetResponses()),
 		Callbacks:    toCallbacks(op.Callbacks),
-		Deprecated:   &op.Deprecated,
-		Security:     toSecurityRequirements(op.Security),
 		Servers:      toServers(op.Servers),
 		Extensions:   toExtensions(op.SpecificationExtension),
 	}
+	if op.Deprecated {
+		oper.Deprecated = &op.Deprecated
+	}
+	if len(op.Security) > 0 {
+		oper.Security = toSecurityRequirements(op.Security)
+	}
+	return oper
 }
 
 func toParameters(params []*goa3.ParameterOrReference) []*v3.Parameter {
@@ -554,24 +662,9 @@ func toParameters(params []*goa3.ParameterOrReference) []*v3.Parameter {
 	return parameters
 }
 
-func toParameter(paramOrRef *goa3.ParameterOrReference) *v3.Parameter {
-	if paramOrRef == nil || paramOrRef.GetParameter() == nil {
-		return nil
-	}
-	param := paramOrRef.GetParameter()
-	return &v3.Parameter{
-		Name:            param.GetName(),
-		In:              param.In,
-		Description:     param.Description,
-		Required:        &param.Required,
-		Deprecated:      param.Deprecated,
-		AllowEmptyValue: param.AllowEmptyValue,
-		Style:           param.Style,
-		Explode:         &param.Explode,
-		AllowReserved:   param.AllowReserved,
-		Schema:          toSchemaOrReference(param.GetSchema()),
-		Example:         param.Example.ToRawInfo(),
-		Content:         toMediaTypes(param.GetContent()),
-		Extensions:      toExtensions(param.GetSpecificationExtension()),
-	}
+// lowReference returns the reference of a low-level object that libopenapi renders as a $ref to location.
+func lowReference(location string) *low.Reference {
+	ref := new(low.Reference)
+	ref.SetReference(location, nil)
+	return ref
 }
@@ -0,0 +1,368 @@
+package gnostic
+
+import (
+	"testing"
+
+	"github.com/google/gnostic/compiler"
+	goa3 "github.com/google/gnostic/openapiv3"
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"gopkg.in/yaml.v3"
+)
+
+// parseYAML parses src with one of the gnostic constructors, which builds the same messages as a gnostic
+// annotation written with the same fields.
+func parseYAML[T any](t *testing.T, src string, parse func(*yaml.Node, *compiler.Context) (T, error)) T {
+	t.Helper()
+	var node yaml.Node
+	require.NoError(t, yaml.Unmarshal([]byte(src), &node))
+	v, err := parse(node.Content[0], compiler.NewContext("$root", node.Content[0], nil))
+	require.NoError(t, err)
+	return v
+}
+
+func render(t *testing.T, r interface{ Render() ([]byte, error) }) string {
+	t.Helper()
+	b, err := r.Render()
+	require.NoError(t, err)
+	return string(b)
+}
+
+func TestSchemaRoundTrip(t *testing.T) {
+	src := `
+type: object
+title: Pet
+description: A pet of the store.
+required: [id, kind]
+nullable: true
+readOnly: true
+deprecated: true
+externalDocs:
+  description: More about pets
+  url: https://example.com/pets
+discriminator:
+  propertyName: kind
+  mapping:
+    cat: '#/components/schemas/Cat'
+    dog: '#/components/schemas/Dog'
+xml:
+  name: pet
+  namespace: https://example.com/schema
+  prefix: ex
+  wrapped: true
+  x-order: 1
+properties:
+  id:
+    type: integer
+    format: int64
+    minimum: 1
+    maximum: 100
+    multipleOf: 1
+    default: 1
+  kind:
+    type: string
+    enum: [cat, dog]
+    default: cat
+  owner:
+    $ref: '#/components/schemas/Owner'
+  tags:
+    type: array
+    items:
+      type: string
+      pattern: ^[a-z]+$
+      minLength: 1
+      maxLength: 10
+    minItems: 1
+    maxItems: 5
+    uniqueItems: true
+  labels:
+    type: object
+    additionalProperties:
+      $ref: '#/components/schemas/Label'
+    minProperties: 1
+    maxProperties: 3
+  rating:
+    type: number
+    default: 2.5
+  adopted:
+    type: boolean
+    default: false
+  home:
+    allOf:
+      - $ref: '#/components/schemas/Address'
+    oneOf:
+      - $ref: '#/components/schemas/House'
+      - $ref: '#/components/schemas/Flat'
+    anyOf:
+      - type: string
+    not:
+      type: integer
+x-speakeasy-entity: Pet
+`
+	s := parseYAML(t, src, goa3.NewSchema)
+	assert.YAMLEq(t, src, render(t, base.CreateSchemaProxy(toSchema(s))))
+}
+
+func TestSchemaOpenAPI31(t *testing.T) {
+	// Keywords whose meaning changed in OpenAPI 3.1 are written in their 3.1 form.
+	s := parseYAML(t, `
+type: integer
+example: 42
+minimum: 1
+exclusiveMinimum: true
+maximum: 10
+exclusiveMaximum: true
+`, goa3.NewSchema)
+	assert.YAMLEq(t, `
+type: integer
+examples: [42]
+exclusiveMinimum: 1
+exclusiveMaximum: 10
+`, render(t, base.CreateSchemaProxy(toSchema(s))))
+}
+
+func TestSchemaPackedExample(t *testing.T) {
+	value, err := anypb.New(wrapperspb.String("Rex"))
+	require.NoError(t, err)
+	s := &goa3.Schema{Type: "string", Example: &goa3.Any{Value: value}}
+	assert.YAMLEq(t, "type: string\nexamples: [Rex]\n", render(t, base.CreateSchemaProxy(toSchema(s))))
+}
+
+func TestOperationRoundTrip(t *testing.T) {
+	src := `
+tags: [pets]
+summary: Update a pet
+description: Updates a pet in the store.
+externalDocs:
+  url: https://example.com/docs
+operationId: updatePet
+parameters:
+  - $ref: '#/components/parameters/TraceId'
+  - name: id
+    in: path
+    required: true
+    description: The pet id.
+    schema:
+      type: string
+    example: rex
+  - name: fields
+    in: query
+    style: form
+    explode: true
+    deprecated: true
+    schema:
+      type: array
+      items:
+        type: string
+    examples:
+      some:
+        summary: Some fields
+        value: [name, kind]
+  - name: filter
+    in: query
+    content:
+      application/json:
+        schema:
+          $ref: '#/components/schemas/Filter'
+requestBody:
+  $ref: '#/components/requestBodies/Pet'
+responses:
+  "200":
+    description: The updated pet.
+    headers:
+      X-Rate-Limit:
+        description: Calls left.
+        required: true
+        schema:
+          type: integer
+      X-Request-Id:
+        $ref: '#/components/headers/RequestId'
+    content:
+      application/json:
+        schema:
+          $ref: '#/components/schemas/Pet'
+        example:
+          name: Rex
+    links:
+      GetPet:
+        operationId: getPet
+        parameters:
+          id: $response.body#/id
+  "404":
+    $ref: '#/components/responses/NotFound'
+  default:
+    description: Unexpected error.
+  x-retry: true
+callbacks:
+  onUpdate:
+    '{$request.body#/callbackUrl}':
+      post:
+        responses:
+          "200":
+            description: Received.
+deprecated: true
+security:
+  - petstore_auth: [write:pets]
+servers:
+  - url: https://{region}.example.com
+    variables:
+      region:
+        default: eu
+        enum: [eu, us]
+x-codegen: skip
+`
+	op := parseYAML(t, src, goa3.NewOperation)
+	assert.YAMLEq(t, src, render(t, toOperation(op)))
+}
+
+func TestComponentsRoundTrip(t *testing.T) {
+	src := `
+schemas:
+  Pet:
+    type: object
+    properties:
+      name:
+        type: string
+responses:
+  NotFound:
+    description: Not found.
+    content:
+      application/json:
+        schema:
+          $ref: '#/components/schemas/Error'
+parameters:
+  TraceId:
+    name: X-Trace-Id
+    in: header
+    schema:
+      type: string
+examples:
+  Rex:
+    summary: A dog
+    value:
+      name: Rex
+  Tom:
+    $ref: '#/components/examples/Cat'
+requestBodies:
+  Pet:
+    description: A pet.
+    required: true
+    content:
+      application/json:
+        schema:
+          $ref: '#/components/schemas/Pet'
+headers:
+  RequestId:
+    description: The request id.
+    schema:
+      type: string
+securitySchemes:
+  bearer:
+    type: http
+    scheme: bearer
+    bearerFormat: JWT
+    description: A JSON web token.
+  petstore_auth:
+    type: oauth2
+    flows:
+      authorizationCode:
+        authorizationUrl: https://example.com/authorize
+        tokenUrl: https://example.com/token
+        scopes:
+          write:pets: Modify pets
+  api_key:
+    type: apiKey
+    name: api_key
+    in: header
+    x-internal: true
+links:
+  GetOwner:
+    operationId: getOwner
+    description: The owner of the pet.
+callbacks:
+  Ping:
+    '{$request.body#/url}':
+      get:
+        responses:
+          "200":
+            description: Pong.
+x-components: true
+`
+	c := parseYAML(t, src, goa3.NewComponents)
+	spec := &v3.Document{Components: &v3.Components{
+		Schemas:         orderedmap.New[string, *base.SchemaProxy](),
+		Responses:       orderedmap.New[string, *v3.Response](),
+		Parameters:      orderedmap.New[string, *v3.Parameter](),
+		Examples:        orderedmap.New[string, *base.Example](),
+		RequestBodies:   orderedmap.New[string, *v3.RequestBody](),
+		Headers:         orderedmap.New[string, *v3.Header](),
+		SecuritySchemes: orderedmap.New[string, *v3.SecurityScheme](),
+		Links:           orderedmap.New[string, *v3.Link](),
+		Callbacks:       orderedmap.New[string, *v3.Callback](),
+	}}
+	appendComponents(spec, c)
+	assert.YAMLEq(t, src, render(t, spec.Components))
+}
+
+func TestDocumentRoundTrip(t *testing.T) {
+	src := `
+openapi: 3.0.3
+info:
+  title: Petstore
+  summary: Pets as a service.
+  description: All the pets.
+  termsOfService: https://example.com/terms
+  contact:
+    name: Support
+    url: https://example.com/support
+    email: support@example.com
+    x-team: pets
+  license:
+    name: MIT
+    url: https://opensource.org/licenses/MIT
+  version: 1.2.3
+  x-logo:
+    url: https://example.com/logo.png
+servers:
+  - url: https://api.example.com
+    description: Production
+security:
+  - bearer: []
+tags:
+  - name: pets
+    description: Everything about pets.
+    externalDocs:
+      description: Find out more
+      url: https://example.com/pets
+paths:
+  /health:
+    get:
+      operationId: health
+      responses:
+        "200":
+          description: Healthy.
+externalDocs:
+  url: https://example.com
+x-tagGroups:
+  - name: Store
+    tags: [pets]
+`
+	d := parseYAML(t, src, goa3.NewDocument)
+	spec := &v3.Document{}
+	specWithDocument(spec, d)
+	assert.YAMLEq(t, src, render(t, spec))
+}
+
+func TestDocumentKeepsGeneratedInfo(t *testing.T) {
+	spec := &v3.Document{Info: &base.Info{Title: "foo.v1", Description: "From comments."}}
+	specWithDocument(spec, &goa3.Document{Info: &goa3.Info{Version: "v1"}})
+	assert.Equal(t, "foo.v1", spec.Info.Title)
+	assert.Equal(t, "From comments.", spec.Info.Description)
+	assert.Equal(t, "v1", spec.Info.Version)
+	assert.Empty(t, spec.Version)
+}
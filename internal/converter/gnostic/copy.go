@@ -5,52 +5,21 @@ import (
 	"github.com/pb33f/libopenapi/datamodel/high/base"
 	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
 	"github.com/pb33f/libopenapi/orderedmap"
+	"github.com/pb33f/libopenapi/utils"
 	"github.com/samber/lo"
 	"gopkg.in/yaml.v3"
 )
 
 func ToSecurityRequirements(securityReq []*goa3.SecurityRequirement) []*base.SecurityRequirement {
-	result := make([]*base.SecurityRequirement, len(securityReq))
-	for i, req := range securityReq {
-		reqs := orderedmap.New[string, []string]()
-		for _, prop := range req.AdditionalProperties {
-			var val []string
-			if prop.Value != nil {
-				val = prop.Value.Value
-			}
-			reqs.Set(prop.Name, val)
-		}
-
-		result[i] = &base.SecurityRequirement{
-			Requirements:             reqs,
-			ContainsEmptyRequirement: len(req.AdditionalProperties) == 0,
-		}
-	}
-	return result
+	return toSecurityRequirements(securityReq)
 }
 
 func ToServers(servers []*goa3.Server) []*v3.Server {
-	if len(servers) == 0 {
-		return nil
-	}
-
-	result := make([]*v3.Server, len(servers))
-	for i, server := range servers {
-		result[i] = ToServer(server)
-	}
-	return result
+	return toServers(servers)
 }
 
 func ToServer(server *goa3.Server) *v3.Server {
-	if server == nil {
-		return nil
-	}
-
-	return &v3.Server{
-		URL:         server.Url,
-		Description: server.Description,
-		Variables:   toVariables(server.Variables),
-	}
+	return toServer(server)
 }
 
 func ToParametersMap(params *goa3.ParametersOrReferences) *orderedmap.Map[string, *v3.Parameter] {
@@ -72,14 +41,17 @@ func ToExtensions(items []*goa3.NamedAny) *orderedmap.Map[string, *yaml.Node] {
 		return nil
 	}
 
-	extensions := orderedmap.New[string, *yaml.Node]()
-	for _, namedAny := range items {
-		extensions.Set(namedAny.Name, namedAny.Value.ToRawInfo())
-	}
-	return extensions
+	return toExtensions(items)
 }
 
 func toParameterV1(paramOrRef *goa3.ParameterOrReference) *v3.Parameter {
+	if ref := paramOrRef.GetReference(); ref != nil {
+		// libopenapi drops the parameters listed after a low-level reference, so the $ref is written like the
+		// references of message fields.
+		extensions := orderedmap.New[string, *yaml.Node]()
+		extensions.Set("$ref", utils.CreateStringNode(ref.XRef))
+		return &v3.Parameter{Extensions: extensions}
+	}
 	if paramOrRef == nil || paramOrRef.GetParameter() == nil {
 		return nil
 	}
@@ -89,19 +61,30 @@ func toParameterV1(paramOrRef *goa3.ParameterOrReference) *v3.Parameter {
 		Name:            param.GetName(),
 		In:              param.In,
 		Description:     param.Description,
-		Required:        &param.Required,
 		Deprecated:      param.Deprecated,
 		AllowEmptyValue: param.AllowEmptyValue,
 		Style:           param.Style,
-		Explode:         &param.Explode,
 		AllowReserved:   param.AllowReserved,
 		Schema:          toSchemaOrReference(param.GetSchema()),
+		Example:         toAny(param.Example),
+		Examples:        toExamples(param.GetExamples()),
 		Content:         toMediaTypes(param.GetContent()),
 		Extensions:      ToExtensions(param.GetSpecificationExtension()),
 	}
-
-	if param.Example != nil {
-		p.Example = param.Example.ToRawInfo()
+	// Like gnostic, only write the flags that are set.
+	if param.Required {
+		p.Required = &param.Required
+	}
+	if param.Explode {
+		p.Explode = &param.Explode
 	}
 	return p
 }
+
+func ToResponses(responses *goa3.Responses) *v3.Responses {
+	return toResponses(responses)
+}
+
+func ToExternalDocs(externalDocs *goa3.ExternalDocs) *base.ExternalDoc {
+	return toExternalDocs(externalDocs)
+}
@@ -4,11 +4,16 @@ import (
 	goa3 "github.com/google/gnostic/openapiv3"
 	highbase "github.com/pb33f/libopenapi/datamodel/high/base"
 	highv3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"github.com/pubgo/protoc-gen-openapi/generator"
+	"github.com/pubgo/protoc-gen-openapi/internal/converter/util"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
+	"gopkg.in/yaml.v3"
 )
 
 func SpecWithFileAnnotations(spec *highv3.Document, fd protoreflect.FileDescriptor) {
+	specWithSecuritySchemes(spec, util.GetFileOptions(fd))
 	if !proto.HasExtension(fd.Options(), goa3.E_Document.TypeDescriptor().Type()) {
 		return
 	}
@@ -18,29 +23,51 @@ func SpecWithFileAnnotations(spec *highv3.Document, fd protoreflect.FileDescript
 	if !ok {
 		return
 	}
+	specWithDocument(spec, opts)
+}
+
+// specWithDocument merges a gnostic document into spec. Like the gnostic generator, fields left empty in the
+// document keep the generated values.
+func specWithDocument(spec *highv3.Document, opts *goa3.Document) {
 	if opts.Openapi != "" {
-		spec.Info.Version = opts.Openapi
+		spec.Version = opts.Openapi
 	}
 
 	if opts.Info != nil {
-		spec.Info.Title = opts.Info.Title
-		spec.Info.Summary = opts.Info.Summary
-		spec.Info.Description = opts.Info.Description
-		spec.Info.TermsOfService = opts.Info.TermsOfService
+		if spec.Info == nil {
+			spec.Info = &highbase.Info{}
+		}
+		if opts.Info.Title != "" {
+			spec.Info.Title = opts.Info.Title
+		}
+		if opts.Info.Summary != "" {
+			spec.Info.Summary = opts.Info.Summary
+		}
+		if opts.Info.Description != "" {
+			spec.Info.Description = opts.Info.Description
+		}
+		if opts.Info.TermsOfService != "" {
+			spec.Info.TermsOfService = opts.Info.TermsOfService
+		}
 		if opts.Info.Contact != nil {
 			spec.Info.Contact = &highbase.Contact{
-				Name:  opts.Info.Contact.Name,
-				URL:   opts.Info.Contact.Url,
-				Email: opts.Info.Contact.Email,
+				Name:       opts.Info.Contact.Name,
+				URL:        opts.Info.Contact.Url,
+				Email:      opts.Info.Contact.Email,
+				Extensions: toExtensions(opts.Info.Contact.SpecificationExtension),
 			}
 		}
 		if opts.Info.License != nil {
 			spec.Info.License = &highbase.License{
-				Name: opts.Info.License.Name,
-				URL:  opts.Info.License.Url,
+				Name:       opts.Info.License.Name,
+				URL:        opts.Info.License.Url,
+				Extensions: toExtensions(opts.Info.License.SpecificationExtension),
 			}
 		}
-		spec.Info.Version = opts.Info.Version
+		if opts.Info.Version != "" {
+			spec.Info.Version = opts.Info.Version
+		}
+		spec.Info.Extensions = mergeExtensions(spec.Info.Extensions, opts.Info.SpecificationExtension)
 	}
 	spec.Servers = append(spec.Servers, toServers(opts.Servers)...)
 	spec.Security = append(spec.Security, toSecurityRequirements(opts.Security)...)
@@ -48,11 +75,51 @@ func SpecWithFileAnnotations(spec *highv3.Document, fd protoreflect.FileDescript
 	if exDocs := toExternalDocs(opts.ExternalDocs); exDocs != nil {
 		spec.ExternalDocs = exDocs
 	}
-	if opts.SpecificationExtension != nil {
-		ext := toExtensions(opts.SpecificationExtension)
-		for pair := ext.Oldest(); pair != nil; pair = pair.Next() {
-			spec.Extensions.AddPairs(*pair)
+	if paths := opts.Paths.GetPath(); len(paths) > 0 {
+		if spec.Paths == nil {
+			spec.Paths = &highv3.Paths{}
+		}
+		if spec.Paths.PathItems == nil {
+			spec.Paths.PathItems = orderedmap.New[string, *highv3.PathItem]()
 		}
+		for _, item := range paths {
+			// Generated paths win over the ones written in the document.
+			if _, ok := spec.Paths.PathItems.Get(item.Name); !ok {
+				spec.Paths.PathItems.Set(item.Name, toPathItem(item.Value))
+			}
+		}
+		spec.Paths.Extensions = mergeExtensions(spec.Paths.Extensions, opts.Paths.SpecificationExtension)
 	}
+	spec.Extensions = mergeExtensions(spec.Extensions, opts.SpecificationExtension)
 	appendComponents(spec, opts.Components)
 }
+
+// specWithSecuritySchemes adds the security schemes of the openapi.v3.file option to the components of spec.
+func specWithSecuritySchemes(spec *highv3.Document, file *generator.File) {
+	if len(file.GetSecuritySchemes()) == 0 {
+		return
+	}
+	if spec.Components == nil {
+		spec.Components = &highv3.Components{}
+	}
+	if spec.Components.SecuritySchemes == nil {
+		spec.Components.SecuritySchemes = orderedmap.New[string, *highv3.SecurityScheme]()
+	}
+	for _, scheme := range file.GetSecuritySchemes() {
+		spec.Components.SecuritySchemes.Set(scheme.GetName(), util.SecurityScheme(scheme))
+	}
+}
+
+// mergeExtensions adds the specification extensions in items to extensions, which is allocated if needed.
+func mergeExtensions(extensions *orderedmap.Map[string, *yaml.Node], items []*goa3.NamedAny) *orderedmap.Map[string, *yaml.Node] {
+	if len(items) == 0 {
+		return extensions
+	}
+	if extensions == nil {
+		extensions = orderedmap.New[string, *yaml.Node]()
+	}
+	for pair := toExtensions(items).First(); pair != nil; pair = pair.Next() {
+		extensions.Set(pair.Key(), pair.Value())
+	}
+	return extensions
+}
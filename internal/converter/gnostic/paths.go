@@ -2,22 +2,52 @@ package gnostic
 
 import (
 	goa3 "github.com/google/gnostic/openapiv3"
+	"github.com/pb33f/libopenapi/datamodel/high/base"
 	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"github.com/pubgo/protoc-gen-openapi/internal/converter/util"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
+	"gopkg.in/yaml.v3"
 )
 
-func PathItemWithMethodAnnotations(item *v3.PathItem, md protoreflect.MethodDescriptor) *v3.PathItem {
+// GetOperation returns the openapi.v3.operation option of a method, or nil if it isn't set.
+func GetOperation(md protoreflect.MethodDescriptor) *goa3.Operation {
 	if !proto.HasExtension(md.Options(), goa3.E_Operation.TypeDescriptor().Type()) {
-		return item
+		return nil
 	}
 
 	ext := proto.GetExtension(md.Options(), goa3.E_Operation.TypeDescriptor().Type())
 	opts, ok := ext.(*goa3.Operation)
 	if !ok {
-		return item
+		return nil
+	}
+	return opts
+}
+
+func PathItemWithMethodAnnotations(item *v3.PathItem, md protoreflect.MethodDescriptor) *v3.PathItem {
+	OperationsWithMethodAnnotations(item.GetOperations(), md)
+	return item
+}
+
+// OperationsWithMethodAnnotations applies the openapi.v3.method and openapi.v3.operation options of a method
+// to its operations, keyed by HTTP method.
+func OperationsWithMethodAnnotations(operations *orderedmap.Map[string, *v3.Operation], md protoreflect.MethodDescriptor) {
+	methodOpts := util.GetMethodOptions(md)
+	for oper := range operations.ValuesFromOldest() {
+		switch {
+		case methodOpts.GetPublic():
+			// An empty list, rather than none, overrides the security of the document.
+			oper.Security = []*base.SecurityRequirement{}
+		case len(methodOpts.GetSecurity()) > 0:
+			oper.Security = util.SecurityRequirements(methodOpts.GetSecurity())
+		}
+	}
+
+	opts := GetOperation(md)
+	if opts == nil {
+		return
 	}
-	operations := item.GetOperations()
 	for kv := operations.First(); kv != nil; kv = kv.Next() {
 		oper := kv.Value()
 		if opts.Deprecated {
@@ -25,12 +55,10 @@ func PathItemWithMethodAnnotations(item *v3.PathItem, md protoreflect.MethodDesc
 			oper.Deprecated = &t
 		}
 
-		for _, param := range opts.Parameters {
-			item.Parameters = append(item.Parameters, toParameterV1(param))
-		}
+		oper.Parameters = append(oper.Parameters, toParameters(opts.Parameters)...)
 
 		if opts.RequestBody != nil {
-			oper.RequestBody = toRequestBody(opts.RequestBody.GetRequestBody())
+			oper.RequestBody = toRequestBody(opts.RequestBody)
 		}
 
 		if opts.Responses != nil {
@@ -41,6 +69,9 @@ func PathItemWithMethodAnnotations(item *v3.PathItem, md protoreflect.MethodDesc
 			if responses.Default != nil {
 				oper.Responses.Default = responses.Default
 			}
+			if responses.Extensions != nil && oper.Responses.Extensions == nil {
+				oper.Responses.Extensions = orderedmap.New[string, *yaml.Node]()
+			}
 			for pair := responses.Extensions.First(); pair != nil; pair = pair.Next() {
 				oper.Responses.Extensions.Set(pair.Key(), pair.Value())
 			}
@@ -53,7 +84,9 @@ func PathItemWithMethodAnnotations(item *v3.PathItem, md protoreflect.MethodDesc
 		if security := toSecurityRequirements(opts.Security); len(security) > 0 {
 			oper.Security = security
 		}
-		oper.Servers = toServers(opts.Servers)
+		if servers := toServers(opts.Servers); len(servers) > 0 {
+			oper.Servers = servers
+		}
 
 		if opts.Summary != "" {
 			oper.Summary = opts.Summary
@@ -72,8 +105,12 @@ func PathItemWithMethodAnnotations(item *v3.PathItem, md protoreflect.MethodDesc
 		}
 
 		if opts.SpecificationExtension != nil {
-			oper.Extensions = toExtensions(opts.GetSpecificationExtension())
+			if oper.Extensions == nil {
+				oper.Extensions = orderedmap.New[string, *yaml.Node]()
+			}
+			for pair := toExtensions(opts.GetSpecificationExtension()).First(); pair != nil; pair = pair.Next() {
+				oper.Extensions.Set(pair.Key(), pair.Value())
+			}
 		}
 	}
-	return item
 }
@@ -1,12 +1,8 @@
 package gnostic
 
 import (
-	"log/slog"
-
 	goa3 "github.com/google/gnostic/openapiv3"
 	"github.com/pb33f/libopenapi/datamodel/high/base"
-	"github.com/pb33f/libopenapi/orderedmap"
-	"github.com/pb33f/libopenapi/utils"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"gopkg.in/yaml.v3"
@@ -58,25 +54,9 @@ func schemaWithAnnotations(schema *base.Schema, opts *goa3.Schema) *base.Schema
 	if opts.WriteOnly {
 		schema.WriteOnly = &opts.WriteOnly
 	}
-	if opts.Example != nil {
-		// If the example is defined with the YAML option
-		if opts.Example.Yaml != "" {
-			var v string
-			if err := yaml.Unmarshal([]byte(opts.Example.GetYaml()), &v); err != nil {
-				var node yaml.Node
-				if err := yaml.Unmarshal([]byte(opts.Example.GetYaml()), &node); err != nil {
-					slog.Warn("unable to unmarshal example", slog.Any("error", err))
-				} else {
-					schema.Examples = append(schema.Examples, &node)
-				}
-			} else {
-				schema.Examples = append(schema.Examples, utils.CreateStringNode(v))
-			}
-		}
-		// If the example is defined with google.protobuf.Any
-		if opts.Example.Value != nil {
-			slog.Warn("unable to unmarshal pb.any example")
-		}
+	if example := toAny(opts.Example); example != nil {
+		// OpenAPI 3.1 deprecates example in favor of the examples array.
+		schema.Examples = append(schema.Examples, example)
 	}
 	if opts.ExternalDocs != nil {
 		schema.ExternalDocs = toExternalDocs(opts.ExternalDocs)
@@ -112,29 +92,19 @@ func schemaWithAnnotations(schema *base.Schema, opts *goa3.Schema) *base.Schema
 		schema.Pattern = opts.Pattern
 	}
 	if opts.MaxItems > 0 {
-		if schema.ParentProxy != nil {
-			schema.ParentProxy.Schema().MaxItems = &opts.MaxItems
-		}
+		schema.MaxItems = &opts.MaxItems
 	}
 	if opts.MinItems > 0 {
-		if schema.ParentProxy != nil {
-			schema.ParentProxy.Schema().MinItems = &opts.MinItems
-		}
+		schema.MinItems = &opts.MinItems
 	}
 	if opts.UniqueItems {
-		if schema.ParentProxy != nil {
-			schema.ParentProxy.Schema().UniqueItems = &opts.UniqueItems
-		}
+		schema.UniqueItems = &opts.UniqueItems
 	}
 	if opts.MaxProperties > 0 {
-		if schema.ParentProxy != nil {
-			schema.ParentProxy.Schema().MaxProperties = &opts.MaxProperties
-		}
+		schema.MaxProperties = &opts.MaxProperties
 	}
 	if opts.MinProperties > 0 {
-		if schema.ParentProxy != nil {
-			schema.ParentProxy.Schema().MinProperties = &opts.MinProperties
-		}
+		schema.MinProperties = &opts.MinProperties
 	}
 	if len(opts.Required) > 0 {
 		schema.Required = opts.Required
@@ -150,17 +120,6 @@ func schemaWithAnnotations(schema *base.Schema, opts *goa3.Schema) *base.Schema
 		schema.Type = []string{opts.Type}
 	}
 
-	if opts.AdditionalProperties != nil {
-		switch v := opts.AdditionalProperties.GetOneof().(type) {
-		case *goa3.AdditionalPropertiesItem_SchemaOrReference:
-			if vv := toSchemaOrReference(v.SchemaOrReference); vv != nil {
-				schema.AdditionalProperties = &base.DynamicValue[*base.SchemaProxy, bool]{A: vv}
-			}
-		case *goa3.AdditionalPropertiesItem_Boolean:
-			schema.AdditionalProperties = &base.DynamicValue[*base.SchemaProxy, bool]{N: 1, B: v.Boolean}
-		}
-	}
-
 	if len(opts.AllOf) > 0 {
 		schema.AllOf = toSchemaOrReferences(opts.AllOf)
 	}
@@ -193,28 +152,17 @@ func schemaWithAnnotations(schema *base.Schema, opts *goa3.Schema) *base.Schema
 		schema.AdditionalProperties = toAdditionalPropertiesItem(opts.AdditionalProperties)
 	}
 	if opts.Xml != nil {
-		extensions := *orderedmap.New[string, *yaml.Node]()
-		for _, namedAny := range opts.Xml.GetSpecificationExtension() {
-			extensions.Set(namedAny.Name, namedAny.ToRawInfo())
-		}
 		schema.XML = &base.XML{
 			Name:       opts.Xml.Name,
 			Namespace:  opts.Xml.Namespace,
 			Prefix:     opts.Xml.Prefix,
 			Attribute:  opts.Xml.Attribute,
 			Wrapped:    opts.Xml.Wrapped,
-			Extensions: &extensions,
+			Extensions: toExtensions(opts.Xml.GetSpecificationExtension()),
 		}
 	}
 	if opts.Discriminator != nil {
-		mapping := orderedmap.New[string, string]()
-		for _, prop := range opts.Discriminator.GetMapping().GetAdditionalProperties() {
-			mapping.Set(prop.Name, prop.Value)
-		}
-		schema.Discriminator = &base.Discriminator{
-			PropertyName: opts.Discriminator.GetPropertyName(),
-			Mapping:      mapping,
-		}
+		schema.Discriminator = toDiscriminator(opts.Discriminator)
 	}
 	if opts.SpecificationExtension != nil {
 		schema.Extensions = toExtensions(opts.SpecificationExtension)
@@ -0,0 +1,46 @@
+package googleapi
+
+import (
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/pubgo/protoc-gen-openapi/internal/converter/options"
+	"github.com/pubgo/protoc-gen-openapi/internal/converter/util"
+)
+
+// boundParams documents the top-level request fields that the openapi.v3.field option binds to a header or a
+// cookie. The bound fields are added to covered so they are left out of the request body and query.
+func boundParams(opts options.Options, input protoreflect.MessageDescriptor, covered map[string]struct{}) []*v3.Parameter {
+	var params []*v3.Parameter
+	fields := input.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		if !util.IsVisible(opts, field) {
+			continue
+		}
+		fieldOpts := util.GetFieldOptions(field)
+		in, name := "header", fieldOpts.GetHeader()
+		if name == "" {
+			in, name = "cookie", fieldOpts.GetCookie()
+		}
+		if name == "" {
+			continue
+		}
+		covered[string(field.FullName())] = struct{}{}
+		covered[util.MakeFieldName(opts, field)] = struct{}{}
+
+		parent := &base.Schema{}
+		param := &v3.Parameter{
+			Name:        name,
+			In:          in,
+			Description: util.FormatComments(opts, field.ParentFile().SourceLocations().ByDescriptor(field)),
+			Schema:      queryParamSchema(opts, base.CreateSchemaProxy(parent), field),
+		}
+		if len(parent.Required) > 0 {
+			param.Required = util.BoolPtr(true)
+		}
+		params = append(params, param)
+	}
+	return params
+}
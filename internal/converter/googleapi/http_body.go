@@ -0,0 +1,43 @@
+package googleapi
+
+import (
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/pubgo/protoc-gen-openapi/internal/converter/util"
+)
+
+const httpBodyFullName = "google.api.HttpBody"
+
+// isHTTPBody reports if a message is a google.api.HttpBody, whose data is sent as the raw HTTP body with its
+// content_type as the Content-Type header instead of being encoded as JSON.
+func isHTTPBody(md protoreflect.MessageDescriptor) bool {
+	return md != nil && md.FullName() == httpBodyFullName
+}
+
+// httpBodyMediaTypes documents a google.api.HttpBody as binary content of the given media types, or of any
+// media type when there are none.
+func httpBodyMediaTypes(contentTypes []string) *orderedmap.Map[string, *v3.MediaType] {
+	if len(contentTypes) == 0 {
+		contentTypes = []string{"*/*"}
+	}
+	mediaTypes := orderedmap.New[string, *v3.MediaType]()
+	for _, contentType := range contentTypes {
+		mediaTypes.Set(contentType, &v3.MediaType{
+			Schema: base.CreateSchemaProxy(&base.Schema{Type: []string{"string"}, Format: "binary"}),
+		})
+	}
+	return mediaTypes
+}
+
+// requestContentTypes are the media types hinted for the HttpBody request of a method.
+func requestContentTypes(md protoreflect.MethodDescriptor) []string {
+	return util.GetMethodOptions(md).GetRequestContentTypes()
+}
+
+// responseContentTypes are the media types hinted for the HttpBody response of a method.
+func responseContentTypes(md protoreflect.MethodDescriptor) []string {
+	return util.GetMethodOptions(md).GetResponseContentTypes()
+}
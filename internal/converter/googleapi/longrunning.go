@@ -0,0 +1,273 @@
+package googleapi
+
+import (
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"github.com/pb33f/libopenapi/utils"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"gopkg.in/yaml.v3"
+
+	"github.com/pubgo/protoc-gen-openapi/internal/converter/options"
+	"github.com/pubgo/protoc-gen-openapi/internal/converter/util"
+)
+
+const (
+	operationFullName = "google.longrunning.Operation"
+	// operationInfoNumber is the field number of the google.longrunning.operation_info method option.
+	operationInfoNumber = 1049
+)
+
+// OperationInfo holds the types named by the google.longrunning.operation_info option of a method.
+type OperationInfo struct {
+	Response protoreflect.MessageDescriptor
+	Metadata protoreflect.MessageDescriptor
+}
+
+// operationExtension is the value of the x-long-running extension.
+type operationExtension struct {
+	Response string `yaml:"response,omitempty"`
+	Metadata string `yaml:"metadata,omitempty"`
+}
+
+// GetOperationInfo returns the resolved google.longrunning.operation_info option of a method returning a
+// google.longrunning.Operation, or nil if it has none. The longrunning Go types aren't linked into this
+// plugin, so the option is decoded from the wire form of the method options.
+func GetOperationInfo(md protoreflect.MethodDescriptor) *OperationInfo {
+	if md.Output().FullName() != operationFullName || md.Options() == nil {
+		return nil
+	}
+	b, err := proto.Marshal(md.Options())
+	if err != nil {
+		return nil
+	}
+
+	var responseType, metadataType string
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return nil
+		}
+		b = b[n:]
+		if num != operationInfoNumber || typ != protowire.BytesType {
+			n = protowire.ConsumeFieldValue(num, typ, b)
+			if n < 0 {
+				return nil
+			}
+			b = b[n:]
+			continue
+		}
+		v, n := protowire.ConsumeBytes(b)
+		if n < 0 {
+			return nil
+		}
+		b = b[n:]
+		responseType, metadataType = parseOperationInfo(v)
+	}
+
+	info := &OperationInfo{
+		Response: findMessage(md.ParentFile(), responseType),
+		Metadata: findMessage(md.ParentFile(), metadataType),
+	}
+	if info.Response == nil && info.Metadata == nil {
+		return nil
+	}
+	return info
+}
+
+func parseOperationInfo(b []byte) (responseType, metadataType string) {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return
+		}
+		b = b[n:]
+		if typ != protowire.BytesType || (num != 1 && num != 2) {
+			n = protowire.ConsumeFieldValue(num, typ, b)
+			if n < 0 {
+				return
+			}
+			b = b[n:]
+			continue
+		}
+		v, n := protowire.ConsumeString(b)
+		if n < 0 {
+			return
+		}
+		b = b[n:]
+		if num == 1 {
+			responseType = v
+		} else {
+			metadataType = v
+		}
+	}
+	return
+}
+
+// findMessage resolves a message name from operation_info, which may leave out the package of the method.
+func findMessage(fd protoreflect.FileDescriptor, name string) protoreflect.MessageDescriptor {
+	name = strings.TrimPrefix(name, ".")
+	if name == "" {
+		return nil
+	}
+	seen := map[string]struct{}{}
+	if md := findMessageInFile(fd, protoreflect.FullName(name), seen); md != nil {
+		return md
+	}
+	if fd.Package() == "" {
+		return nil
+	}
+	return findMessageInFile(fd, fd.Package().Append(protoreflect.Name(name)), map[string]struct{}{})
+}
+
+func findMessageInFile(fd protoreflect.FileDescriptor, name protoreflect.FullName, seen map[string]struct{}) protoreflect.MessageDescriptor {
+	if _, ok := seen[fd.Path()]; ok {
+		return nil
+	}
+	seen[fd.Path()] = struct{}{}
+
+	if pkg := string(fd.Package()); pkg == "" || strings.HasPrefix(string(name), pkg+".") {
+		rel := strings.TrimPrefix(strings.TrimPrefix(string(name), pkg), ".")
+		messages := fd.Messages()
+		var md protoreflect.MessageDescriptor
+		for _, part := range strings.Split(rel, ".") {
+			if md = messages.ByName(protoreflect.Name(part)); md == nil {
+				break
+			}
+			messages = md.Messages()
+		}
+		if md != nil {
+			return md
+		}
+	}
+
+	imports := fd.Imports()
+	for i := 0; i < imports.Len(); i++ {
+		if md := findMessageInFile(imports.Get(i).FileDescriptor, name, seen); md != nil {
+			return md
+		}
+	}
+	return nil
+}
+
+// ApplyOperationInfo types the Operation returned by a long-running method with the response and metadata
+// messages named by its operation_info option.
+func ApplyOperationInfo(opts options.Options, md protoreflect.MethodDescriptor, op *v3.Operation) {
+	info := GetOperationInfo(md)
+	if info == nil || op == nil || op.Responses == nil || op.Responses.Codes == nil {
+		return
+	}
+	rsp, ok := op.Responses.Codes.Get("200")
+	if !ok || rsp == nil || rsp.Content == nil {
+		return
+	}
+
+	ext := operationExtension{}
+	props := orderedmap.New[string, *base.SchemaProxy]()
+	if info.Metadata != nil {
+		ext.Metadata = string(info.Metadata.FullName())
+		props.Set("metadata", typedAnySchema(info.Metadata))
+	}
+	if info.Response != nil {
+		ext.Response = string(info.Response.FullName())
+		props.Set("response", typedAnySchema(info.Response))
+	}
+	for mediaType := range rsp.Content.ValuesFromOldest() {
+		if mediaType.Schema == nil || mediaType.Schema.GetReference() != "#/components/schemas/"+operationFullName {
+			continue
+		}
+		mediaType.Schema = base.CreateSchemaProxy(&base.Schema{
+			AllOf: []*base.SchemaProxy{
+				base.CreateSchemaProxyRef("#/components/schemas/" + operationFullName),
+				base.CreateSchemaProxy(&base.Schema{Type: []string{"object"}, Properties: props}),
+			},
+		})
+	}
+
+	if op.Extensions == nil {
+		op.Extensions = orderedmap.New[string, *yaml.Node]()
+	}
+	op.Extensions.Set("x-long-running", utils.CreateYamlNode(ext))
+}
+
+// typedAnySchema is a google.protobuf.Any known to hold the given message. protojson inlines the fields of
+// regular messages next to @type, while well-known types with a special JSON form go into a value field.
+func typedAnySchema(md protoreflect.MessageDescriptor) *base.SchemaProxy {
+	ref := base.CreateSchemaProxyRef("#/components/schemas/" + util.FormatTypeRef(string(md.FullName())))
+	props := orderedmap.New[string, *base.SchemaProxy]()
+	props.Set("@type", base.CreateSchemaProxy(&base.Schema{
+		Type:  []string{"string"},
+		Const: utils.CreateStringNode("type.googleapis.com/" + string(md.FullName())),
+	}))
+	if util.IsWellKnown(md) || isWrapper(md) {
+		props.Set("value", ref)
+		return base.CreateSchemaProxy(&base.Schema{Type: []string{"object"}, Properties: props})
+	}
+	return base.CreateSchemaProxy(&base.Schema{
+		AllOf:      []*base.SchemaProxy{ref},
+		Properties: props,
+	})
+}
+
+// LinkOperations links the responses of long-running methods to the GetOperation and CancelOperation methods
+// of google.longrunning.Operations when the document describes that service. additional holds the operations
+// of the document that have no path item field.
+func LinkOperations(doc *v3.Document, additional *util.AdditionalOperations) {
+	if doc == nil || doc.Paths == nil || doc.Paths.PathItems == nil {
+		return
+	}
+
+	targets := map[string]*v3.Operation{}
+	var longRunning []*v3.Operation
+	for path, item := range doc.Paths.PathItems.FromOldest() {
+		for op := range additional.Operations(path, item).ValuesFromOldest() {
+			switch op.OperationId {
+			case "google.longrunning.Operations.GetOperation", "Operations_GetOperation":
+				targets["getOperation"] = op
+			case "google.longrunning.Operations.CancelOperation", "Operations_CancelOperation":
+				targets["cancelOperation"] = op
+			}
+			if op.Extensions != nil {
+				if _, ok := op.Extensions.Get("x-long-running"); ok {
+					longRunning = append(longRunning, op)
+				}
+			}
+		}
+	}
+
+	for _, op := range longRunning {
+		rsp, ok := op.Responses.Codes.Get("200")
+		if !ok || rsp == nil {
+			continue
+		}
+		for _, name := range []string{"getOperation", "cancelOperation"} {
+			target, ok := targets[name]
+			if !ok {
+				continue
+			}
+			if rsp.Links == nil {
+				rsp.Links = orderedmap.New[string, *v3.Link]()
+			}
+			rsp.Links.Set(name, operationLink(target))
+		}
+	}
+}
+
+// operationLink passes the name of the returned Operation to the target, as its path parameter when it has
+// one and as the request body otherwise.
+func operationLink(target *v3.Operation) *v3.Link {
+	link := &v3.Link{OperationId: target.OperationId}
+	for _, param := range target.Parameters {
+		if param.In == "path" {
+			link.Parameters = orderedmap.New[string, string]()
+			link.Parameters.Set(param.Name, "$response.body#/name")
+			return link
+		}
+	}
+	link.RequestBody = `{"name": "{$response.body#/name}"}`
+	return link
+}
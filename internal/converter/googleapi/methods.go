@@ -0,0 +1,39 @@
+package googleapi
+
+import (
+	"log/slog"
+	"net/http"
+
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/pubgo/protoc-gen-openapi/internal/converter/util"
+)
+
+// setOperation places an operation in the path item field of its HTTP method. Methods without a field, like
+// the arbitrary kinds of a custom HttpRule, go into additional at path instead, and are rendered into the
+// x-additional-operations extension when the document is written.
+func setOperation(md protoreflect.MethodDescriptor, item *v3.PathItem, method string, op *v3.Operation, additional *util.AdditionalOperations, path string) {
+	switch method {
+	case http.MethodGet:
+		item.Get = op
+	case http.MethodPut:
+		item.Put = op
+	case http.MethodPost:
+		item.Post = op
+	case http.MethodDelete:
+		item.Delete = op
+	case http.MethodPatch:
+		item.Patch = op
+	case http.MethodHead:
+		item.Head = op
+	case http.MethodOptions:
+		item.Options = op
+	case http.MethodTrace:
+		item.Trace = op
+	default:
+		slog.Warn("HTTP method has no field on an OpenAPI path item, it is documented in "+util.AdditionalOperationsExtension,
+			slog.String("method", method), slog.Any("rpc", md.FullName()))
+		additional.Set(path, method, op)
+	}
+}
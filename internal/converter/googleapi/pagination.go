@@ -0,0 +1,198 @@
+package googleapi
+
+import (
+	"strconv"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"github.com/pb33f/libopenapi/utils"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"gopkg.in/yaml.v3"
+
+	"github.com/pubgo/protoc-gen-openapi/internal/converter/options"
+	"github.com/pubgo/protoc-gen-openapi/internal/converter/util"
+)
+
+// Pagination holds the fields of an AIP-158 paginated list method.
+type Pagination struct {
+	PageSize      protoreflect.FieldDescriptor
+	PageToken     protoreflect.FieldDescriptor
+	NextPageToken protoreflect.FieldDescriptor
+	// Items is the repeated field holding the page of results, it may be nil.
+	Items protoreflect.FieldDescriptor
+}
+
+// paginationExtension is the value of the x-pagination extension.
+type paginationExtension struct {
+	PageSize        string `yaml:"pageSize"`
+	PageToken       string `yaml:"pageToken"`
+	NextPageToken   string `yaml:"nextPageToken"`
+	Items           string `yaml:"items,omitempty"`
+	DefaultPageSize int    `yaml:"defaultPageSize,omitempty"`
+	MaxPageSize     int    `yaml:"maxPageSize,omitempty"`
+}
+
+// PaginationOf returns the pagination fields of a method whose request has page_size and page_token and whose
+// response has next_page_token, or nil if the method isn't paginated.
+func PaginationOf(md protoreflect.MethodDescriptor) *Pagination {
+	if md.IsStreamingClient() || md.IsStreamingServer() {
+		return nil
+	}
+	if rule, ok := proto.GetExtension(md.Options(), annotations.E_Http).(*annotations.HttpRule); ok && rule.GetResponseBody() != "" {
+		// The response body is a single field of the response, so it doesn't carry next_page_token.
+		return nil
+	}
+
+	p := &Pagination{
+		PageSize:      md.Input().Fields().ByName("page_size"),
+		PageToken:     md.Input().Fields().ByName("page_token"),
+		NextPageToken: md.Output().Fields().ByName("next_page_token"),
+	}
+	if p.PageSize == nil || p.PageToken == nil || p.NextPageToken == nil {
+		return nil
+	}
+	if p.PageSize.IsList() || p.PageToken.IsList() || p.NextPageToken.IsList() {
+		return nil
+	}
+	switch p.PageSize.Kind() {
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind, protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+	default:
+		return nil
+	}
+	if p.PageToken.Kind() != protoreflect.StringKind || p.NextPageToken.Kind() != protoreflect.StringKind {
+		return nil
+	}
+
+	fields := md.Output().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		if field := fields.Get(i); field.IsList() {
+			p.Items = field
+			break
+		}
+	}
+	return p
+}
+
+// ApplyPagination documents a paginated list operation: the x-pagination extension describes the fields, the
+// page size gets its bounds in the query or in the request body, and a link from next_page_token to the
+// page_token parameter lets clients follow the pages.
+func ApplyPagination(opts options.Options, md protoreflect.MethodDescriptor, op *v3.Operation) {
+	p := PaginationOf(md)
+	if p == nil || op == nil {
+		return
+	}
+
+	pageSizeName := util.MakeFieldName(opts, p.PageSize)
+	pageTokenName := util.MakeFieldName(opts, p.PageToken)
+	ext := paginationExtension{
+		PageSize:        pageSizeName,
+		PageToken:       pageTokenName,
+		NextPageToken:   util.MakeFieldName(opts, p.NextPageToken),
+		DefaultPageSize: opts.DefaultPageSize,
+		MaxPageSize:     opts.MaxPageSize,
+	}
+	if p.Items != nil {
+		ext.Items = util.MakeFieldName(opts, p.Items)
+	}
+	if op.Extensions == nil {
+		op.Extensions = orderedmap.New[string, *yaml.Node]()
+	}
+	op.Extensions.Set("x-pagination", utils.CreateYamlNode(ext))
+
+	var hasPageToken bool
+	for _, param := range op.Parameters {
+		if param.In != "query" {
+			continue
+		}
+		switch param.Name {
+		case pageSizeName:
+			if param.Schema != nil {
+				setPageSizeBounds(opts, param.Schema.Schema())
+			}
+		case pageTokenName:
+			hasPageToken = true
+		}
+	}
+
+	if op.RequestBody != nil {
+		for mediaType := range op.RequestBody.Content.ValuesFromOldest() {
+			mediaType.Schema = pageSizeBodySchema(opts, p.PageSize, pageSizeName, mediaType.Schema)
+		}
+	}
+
+	if !hasPageToken || op.Responses == nil || op.Responses.Codes == nil {
+		return
+	}
+	rsp, ok := op.Responses.Codes.Get("200")
+	if !ok || rsp == nil {
+		return
+	}
+
+	// The next call repeats the request with page_token set to the next_page_token of this response.
+	params := orderedmap.New[string, string]()
+	for _, param := range op.Parameters {
+		switch {
+		case param.In == "query" && param.Name == pageTokenName:
+			params.Set(param.Name, "$response.body#/"+ext.NextPageToken)
+		case param.In == "query" || param.In == "path":
+			params.Set(param.Name, "$request."+param.In+"."+param.Name)
+		}
+	}
+	if rsp.Links == nil {
+		rsp.Links = orderedmap.New[string, *v3.Link]()
+	}
+	rsp.Links.Set("nextPage", &v3.Link{
+		OperationId: op.OperationId,
+		Parameters:  params,
+		Description: "Fetch the next page, there are no more pages when " + ext.NextPageToken + " is empty.",
+	})
+}
+
+// pageSizeBodySchema bounds the page size of a request sent as the body, like the ones of POST list methods and
+// of ConnectRPC. The request message is a shared component, so a reference to it gets the bounds next to it.
+func pageSizeBodySchema(opts options.Options, pageSize protoreflect.FieldDescriptor, name string, body *base.SchemaProxy) *base.SchemaProxy {
+	if body == nil {
+		return nil
+	}
+	if !body.IsReference() {
+		if s := body.Schema(); s != nil && s.Properties != nil {
+			if property, ok := s.Properties.Get(name); ok && property != nil && !property.IsReference() {
+				setPageSizeBounds(opts, property.Schema())
+			}
+		}
+		return body
+	}
+	if body.GetReference() != "#/components/schemas/"+util.FormatTypeRef(string(pageSize.ContainingMessage().FullName())) {
+		return body
+	}
+	bounds := &base.Schema{}
+	setPageSizeBounds(opts, bounds)
+	props := orderedmap.New[string, *base.SchemaProxy]()
+	props.Set(name, base.CreateSchemaProxy(bounds))
+	return base.CreateSchemaProxy(&base.Schema{
+		AllOf: []*base.SchemaProxy{
+			body,
+			base.CreateSchemaProxy(&base.Schema{Type: []string{"object"}, Properties: props}),
+		},
+	})
+}
+
+func setPageSizeBounds(opts options.Options, s *base.Schema) {
+	if s == nil {
+		return
+	}
+	// A zero or missing page size lets the server pick its default.
+	if s.Minimum == nil && s.ExclusiveMinimum == nil {
+		util.SetNonNegative(s)
+	}
+	if opts.DefaultPageSize > 0 && s.Default == nil {
+		s.Default = utils.CreateIntNode(strconv.Itoa(opts.DefaultPageSize))
+	}
+	if opts.MaxPageSize > 0 && s.Maximum == nil {
+		maximum := float64(opts.MaxPageSize)
+		s.Maximum = &maximum
+	}
+}
@@ -2,19 +2,20 @@ package googleapi
 
 import (
 	"fmt"
-	"iter"
 	"log/slog"
 	"net/http"
-	"regexp"
 	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/pb33f/libopenapi/datamodel/high/base"
 	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
 	"github.com/pb33f/libopenapi/orderedmap"
+	"github.com/pb33f/libopenapi/utils"
 	"google.golang.org/genproto/googleapis/api/annotations"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
+	"gopkg.in/yaml.v3"
 
 	"github.com/pubgo/protoc-gen-openapi/internal/converter/options"
 	"github.com/pubgo/protoc-gen-openapi/internal/converter/schema"
@@ -88,10 +89,25 @@ func mergeOrAppendParameter(existingParams []*v3.Parameter, newParam *v3.Paramet
 	return existingParams
 }
 
-// namedPathPattern is a regular expression to match named path patterns in the form {name=path/*/pattern}
-var namedPathPattern = regexp.MustCompile("{(.+)=(.+)}")
+func MakePathItems(opts options.Options, md protoreflect.MethodDescriptor) (*orderedmap.Map[string, *v3.PathItem], *util.AdditionalOperations) {
+	if opts.IgnoreGoogleapiHTTP {
+		return nil, nil
+	}
+	mdopts := md.Options()
+	if !proto.HasExtension(mdopts, annotations.E_Http) {
+		return nil, nil
+	}
+	rule, ok := proto.GetExtension(mdopts, annotations.E_Http).(*annotations.HttpRule)
+	if !ok {
+		return nil, nil
+	}
+	additional := util.NewAdditionalOperations()
+	return httpRuleToPathMap(opts, md, rule, additional), additional
+}
 
-func MakePathItems(opts options.Options, md protoreflect.MethodDescriptor) *orderedmap.Map[string, *v3.PathItem] {
+// MethodPaths returns the paths MakePathItems documents a method at, the path of its google.api.http option
+// followed by those of its additional bindings, without building their operations.
+func MethodPaths(opts options.Options, md protoreflect.MethodDescriptor) []string {
 	if opts.IgnoreGoogleapiHTTP {
 		return nil
 	}
@@ -103,28 +119,53 @@ func MakePathItems(opts options.Options, md protoreflect.MethodDescriptor) *orde
 	if !ok {
 		return nil
 	}
-	return httpRuleToPathMap(opts, md, rule)
+	return httpRulePaths(opts, md, rule)
 }
 
-func httpRuleToPathMap(opts options.Options, md protoreflect.MethodDescriptor, rule *annotations.HttpRule) *orderedmap.Map[string, *v3.PathItem] {
-	var method, template string
+func httpRulePaths(opts options.Options, md protoreflect.MethodDescriptor, rule *annotations.HttpRule) []string {
+	method, template := httpRulePattern(rule)
+	if method == "" || template == "" {
+		return nil
+	}
+	tokens, err := RunPathPatternLexer(template)
+	if err != nil {
+		return nil
+	}
+	path, _ := renderPathTemplate(opts, md.Input(), tokens)
+	paths := []string{path}
+	for _, binding := range rule.AdditionalBindings {
+		for _, path := range httpRulePaths(opts, md, binding) {
+			paths = append(paths, util.MakePath(opts, path))
+		}
+	}
+	return paths
+}
+
+// httpRulePattern returns the HTTP method and the path template of an HTTP rule, blank if it has no pattern.
+func httpRulePattern(rule *annotations.HttpRule) (string, string) {
 	switch pattern := rule.GetPattern().(type) {
 	case *annotations.HttpRule_Get:
-		method, template = http.MethodGet, pattern.Get
+		return http.MethodGet, pattern.Get
 	case *annotations.HttpRule_Put:
-		method, template = http.MethodPut, pattern.Put
+		return http.MethodPut, pattern.Put
 	case *annotations.HttpRule_Post:
-		method, template = http.MethodPost, pattern.Post
+		return http.MethodPost, pattern.Post
 	case *annotations.HttpRule_Delete:
-		method, template = http.MethodDelete, pattern.Delete
+		return http.MethodDelete, pattern.Delete
 	case *annotations.HttpRule_Patch:
-		method, template = http.MethodPatch, pattern.Patch
+		return http.MethodPatch, pattern.Patch
 	case *annotations.HttpRule_Custom:
-		method, template = pattern.Custom.GetKind(), pattern.Custom.GetPath()
-	default:
-		slog.Warn("invalid type of pattern for HTTP rule", slog.Any("pattern", pattern))
+		return strings.ToUpper(pattern.Custom.GetKind()), pattern.Custom.GetPath()
+	}
+	return "", ""
+}
+
+func httpRuleToPathMap(opts options.Options, md protoreflect.MethodDescriptor, rule *annotations.HttpRule, additional *util.AdditionalOperations) *orderedmap.Map[string, *v3.PathItem] {
+	if rule.GetPattern() == nil {
+		slog.Warn("invalid type of pattern for HTTP rule", slog.Any("pattern", rule.GetPattern()))
 		return nil
 	}
+	method, template := httpRulePattern(rule)
 	if method == "" {
 		slog.Warn("invalid HTTP rule: method is blank", slog.Any("method", md))
 		return nil
@@ -150,10 +191,12 @@ func httpRuleToPathMap(opts options.Options, md protoreflect.MethodDescriptor, r
 	if opts.ShortOperationIds {
 		operationId = string(service.Name()) + "_" + string(md.Name())
 	}
+	summary, description := util.MethodSummary(opts, md)
 	op := &v3.Operation{
-		Summary:     string(md.Name()),
+		Summary:     summary,
 		OperationId: operationId,
-		Description: util.FormatComments(fd.SourceLocations().ByDescriptor(md)),
+		Deprecated:  util.IsMethodDeprecated(md),
+		Description: description,
 	}
 
 	if !opts.WithoutDefaultTags {
@@ -164,13 +207,34 @@ func httpRuleToPathMap(opts options.Options, md protoreflect.MethodDescriptor, r
 		op.Tags = []string{tagName}
 	}
 
+	path, pathParams := renderPathTemplate(opts, md.Input(), tokens)
 	fieldNamesInPath := map[string]struct{}{}
-	for _, param := range partsToParameter(tokens) {
-		// Skip the name parameter if it's part of a glob pattern
-		if strings.Contains(param, "=") {
+	for _, param := range pathParams {
+		if param.FieldPath == "" || param.Segment {
+			// Store the original field name from the glob pattern to prevent it from appearing
+			// in both the path parameters and request body/query parameters
+			if param.FieldPath != "" {
+				fieldNamesInPath[param.FieldPath] = struct{}{}
+			}
+			description := "The " + param.Name + " id."
+			if param.Multi {
+				description = "The " + param.Name + " path, it may span several segments separated by slashes."
+			}
+			newParameter := &v3.Parameter{
+				Name:        param.Name,
+				In:          "path",
+				Required:    proto.Bool(true),
+				Description: description,
+				Schema:      base.CreateSchemaProxy(&base.Schema{Type: []string{"string"}}),
+			}
+			if param.Multi {
+				newParameter.AllowReserved = true
+			}
+			op.Parameters = mergeOrAppendParameter(op.Parameters, newParameter)
 			continue
 		}
-		field, jsonPath := resolveField(md.Input(), param)
+
+		field, jsonPath := resolveField(md.Input(), param.FieldPath)
 		if field != nil {
 			// This field is only top level, so we will filter out the param from
 			// query/param or request body
@@ -178,47 +242,24 @@ func httpRuleToPathMap(opts options.Options, md protoreflect.MethodDescriptor, r
 			fieldNamesInPath[strings.Join(jsonPath, ".")] = struct{}{} // sometimes JSON field names are used
 			loc := fd.SourceLocations().ByDescriptor(field)
 			newParameter := &v3.Parameter{
-				Name:        param,
+				Name:        param.Name,
 				Required:    proto.Bool(true),
 				In:          "path",
-				Description: util.FormatComments(loc),
+				Description: util.FormatComments(opts, loc),
 				Schema:      schema.FieldToSchema(opts, nil, field),
 			}
+			if param.Multi {
+				newParameter.AllowReserved = true
+				newParameter.Description = strings.TrimSpace(newParameter.Description + "\n\nIt may span several segments separated by slashes.")
+			}
 			op.Parameters = mergeOrAppendParameter(op.Parameters, newParameter)
 		} else {
-			slog.Warn("path field not found", slog.String("param", param))
+			slog.Warn("path field not found", slog.String("param", param.FieldPath))
 		}
 	}
-
-	// Add named path parameters from glob patterns
-	for _, token := range tokens {
-		if token.Type == TokenVariable && strings.Contains(token.Value, "=") {
-			matches := namedPathPattern.FindStringSubmatch("{" + token.Value + "}")
-			if len(matches) == 3 {
-				// Store the original field name from the glob pattern to prevent it from appearing
-				// in both the path parameters and request body/query parameters
-				orignalName := matches[1]
-				fieldNamesInPath[orignalName] = struct{}{}
-				// Convert the path from the starred form to use named path parameters.
-				starredPath := matches[2]
-				parts := strings.Split(starredPath, "/")
-				// The starred path is assumed to be in the form "things/*/otherthings/*".
-				// We want to convert it to "things/{thingsId}/otherthings/{otherthingsId}".
-				for i := 0; i < len(parts)-1; i += 2 {
-					section := parts[i]
-					namedPathParameter := util.Singular(section)
-					// Add the parameter to the operation
-					newParameter := &v3.Parameter{
-						Name:        namedPathParameter,
-						In:          "path",
-						Required:    proto.Bool(true),
-						Description: "The " + namedPathParameter + " id.",
-						Schema:      base.CreateSchemaProxy(&base.Schema{Type: []string{"string"}}),
-					}
-					op.Parameters = mergeOrAppendParameter(op.Parameters, newParameter)
-				}
-			}
-		}
+	// Fields read from headers and cookies are left out of the body and query like path fields.
+	for _, param := range boundParams(opts, md.Input(), fieldNamesInPath) {
+		op.Parameters = mergeOrAppendParameter(op.Parameters, param)
 	}
 
 	switch rule.Body {
@@ -232,22 +273,20 @@ func httpRuleToPathMap(opts options.Options, md protoreflect.MethodDescriptor, r
 			_, s := schema.MessageToSchema(opts, md.Input())
 			if s != nil && s.Properties != nil {
 				for name := range fieldNamesInPath {
-					s.Properties.Delete(name)
-					// Also remove from required list to prevent duplicate required properties
-					if s.Required != nil {
-						s.Required = slices.DeleteFunc(s.Required, func(s string) bool {
-							return s == name
-						})
-						// don't serialize []
-						if len(s.Required) == 0 {
-							s.Required = nil
-						}
-					}
+					deleteProperty(s, name)
 				}
 				if s.Properties.Len() > 0 {
 					op.RequestBody = util.MethodToRequestBody(opts, md, base.CreateSchemaProxy(s), false)
 				}
 			}
+		} else if isHTTPBody(md.Input()) {
+			op.RequestBody = &v3.RequestBody{
+				Content:  httpBodyMediaTypes(requestContentTypes(md)),
+				Required: proto.Bool(true),
+			}
+		} else if util.IsMultipart(md) {
+			_, s := schema.MessageToSchema(opts, md.Input())
+			op.RequestBody = util.MethodToRequestBody(opts, md, base.CreateSchemaProxy(s), false)
 		} else {
 			inputName := string(md.Input().FullName())
 			s := base.CreateSchemaProxyRef("#/components/schemas/" + util.FormatTypeRef(inputName))
@@ -258,10 +297,24 @@ func httpRuleToPathMap(opts options.Options, md protoreflect.MethodDescriptor, r
 		if field, jsonPath := resolveField(md.Input(), rule.Body); field != nil {
 			loc := fd.SourceLocations().ByDescriptor(field)
 			bodySchema := schema.FieldToSchema(opts, nil, field)
+			updateMask := updateMaskField(method, md.Input(), field)
+			if updateMask != nil {
+				bodySchema = base.CreateSchemaProxyRef("#/components/schemas/" + PartialSchemaID(field.Message()))
+			}
 			op.RequestBody = &v3.RequestBody{
-				Description: util.FormatComments(loc),
+				Description: util.FormatComments(opts, loc),
 				Content:     util.MakeMediaTypes(opts, bodySchema, false, false),
 			}
+			switch {
+			case field.Kind() == protoreflect.MessageKind && !field.IsList() && isHTTPBody(field.Message()):
+				op.RequestBody.Content = httpBodyMediaTypes(requestContentTypes(md))
+			case field.Kind() == protoreflect.MessageKind && !field.IsList() && !field.IsMap() && util.IsMultipart(md):
+				if _, s := schema.MessageToSchema(opts, field.Message()); s != nil && s.Properties != nil {
+					op.RequestBody.Content = util.MultipartMediaTypes(opts, field.Message(), s)
+				}
+			case util.RawBodyMediaTypes(field) != nil:
+				op.RequestBody.Content = util.RawBodyMediaTypes(field)
+			}
 
 			// Add any unhandled fields in the request message as query parameters.
 			// This covers the case where body: "specific_field" is used, and any fields
@@ -278,6 +331,11 @@ func httpRuleToPathMap(opts options.Options, md protoreflect.MethodDescriptor, r
 			// If body is a nested path (a.b.c) also skip its JSON path
 			coveredFields[strings.Join(jsonPath, ".")] = struct{}{}
 
+			if updateMask != nil {
+				coveredFields[string(updateMask.FullName())] = struct{}{}
+				op.Parameters = mergeOrAppendParameter(op.Parameters, updateMaskParam(opts, updateMask, field.Message()))
+			}
+
 			newQueryParams := flattenToParams(opts, md.Input(), "", coveredFields)
 			for _, newQueryParam := range newQueryParams {
 				op.Parameters = mergeOrAppendParameter(op.Parameters, newQueryParam)
@@ -289,20 +347,28 @@ func httpRuleToPathMap(opts options.Options, md protoreflect.MethodDescriptor, r
 
 	// Responses
 	codeMap := orderedmap.New[string, *v3.Response]()
-	mediaType := orderedmap.New[string, *v3.MediaType]()
 	var outputSchema *base.SchemaProxy
+	outputMessage := md.Output()
 	if rule.ResponseBody == "" {
-		outputSchema = base.CreateSchemaProxyRef("#/components/schemas/" + util.FormatTypeRef(string(md.Output().FullName())))
+		outputSchema = ResponseBodySchema(opts, md.Output())
 	} else {
+		outputMessage = nil
 		if fd, _ := resolveField(md.Output(), rule.ResponseBody); fd != nil {
 			outputSchema = schema.FieldToSchema(opts, nil, fd)
+			if fd.Kind() == protoreflect.MessageKind && !fd.IsList() && !fd.IsMap() {
+				outputMessage = fd.Message()
+			}
 		}
 	}
 
-	mediaType.Set("application/json", &v3.MediaType{Schema: outputSchema})
+	content := util.MakeMediaTypes(opts, outputSchema, false, false)
+	if isHTTPBody(outputMessage) {
+		content = httpBodyMediaTypes(responseContentTypes(md))
+	}
 	codeMap.Set("200", &v3.Response{
 		Description: "Success",
-		Content:     mediaType,
+		Headers:     ResponseHeaders(opts, md.Output()),
+		Content:     content,
 	})
 
 	op.Responses = &v3.Responses{
@@ -311,36 +377,28 @@ func httpRuleToPathMap(opts options.Options, md protoreflect.MethodDescriptor, r
 			Description: "Error",
 			Content: util.MakeMediaTypes(
 				opts,
-				base.CreateSchemaProxyRef("#/components/schemas/connect.error"),
+				base.CreateSchemaProxyRef("#/components/schemas/lava.error"),
 				false,
 				false,
 			),
 		},
 	}
 
-	switch method {
-	case http.MethodGet:
-		pathItem.Get = op
-	case http.MethodPut:
-		pathItem.Put = op
-	case http.MethodPost:
-		pathItem.Post = op
-	case http.MethodDelete:
-		pathItem.Delete = op
-	case http.MethodPatch:
-		pathItem.Patch = op
-	default:
-	}
-	paths.Set(partsToOpenAPIPath(tokens), pathItem)
+	setOperation(md, pathItem, method, op, additional, path)
+	paths.Set(path, pathItem)
 
 	for _, binding := range rule.AdditionalBindings {
-		pathMap := httpRuleToPathMap(opts, md, binding)
+		bindingOperations := util.NewAdditionalOperations()
+		pathMap := httpRuleToPathMap(opts, md, binding, bindingOperations)
 		for pair := pathMap.First(); pair != nil; pair = pair.Next() {
 			path := util.MakePath(opts, pair.Key())
 			paths.Set(path, pair.Value())
+			for op := bindingOperations.Get(pair.Key()).First(); op != nil; op = op.Next() {
+				additional.Set(path, op.Key(), op.Value())
+			}
 		}
 	}
-	dedupeOperations(op.OperationId, paths.ValuesFromOldest())
+	dedupeOperations(op.OperationId, paths, additional)
 	return paths
 }
 
@@ -348,10 +406,10 @@ func httpRuleToPathMap(opts options.Options, md protoreflect.MethodDescriptor, r
 // From the OpenAPI v3 spec: "The id MUST be unique among all operations described in the API."
 // Since the same gRPC method name is used for operationId, the additional bindings will not be unique,
 // so we append a number, starting at 2, when more than one path binds to the same method.
-func dedupeOperations(id string, value iter.Seq[*v3.PathItem]) {
+func dedupeOperations(id string, paths *orderedmap.Map[string, *v3.PathItem], additional *util.AdditionalOperations) {
 	num := 0
-	for path := range value {
-		for op := range path.GetOperations().ValuesFromOldest() {
+	for pair := paths.First(); pair != nil; pair = pair.Next() {
+		for op := range additional.Operations(pair.Key(), pair.Value()).ValuesFromOldest() {
 			if op.OperationId == id {
 				num++
 				if num > 1 {
@@ -390,72 +448,27 @@ func fieldByName(md protoreflect.MessageDescriptor, name string) protoreflect.Fi
 	return nil
 }
 
-func partsToParameter(tokens []Token) []string {
-	params := []string{}
-	for _, token := range tokens {
-		if token.Type == TokenVariable {
-			// Skip parameters that contain = as they are part of a glob pattern
-			if strings.Contains(token.Value, "=") {
-				continue
-			}
-			params = append(params, strings.SplitN(token.Value, "=", 2)[0])
-		}
-	}
-	return params
+// partsToOpenAPIPath renders the path of an HTTP rule. Glob variables are named after the resource pattern of
+// their field in the input message, input may be nil to name them after the singular of their collections.
+func partsToOpenAPIPath(opts options.Options, input protoreflect.MessageDescriptor, tokens []Token) string {
+	path, _ := renderPathTemplate(opts, input, tokens)
+	return path
 }
 
-func partsToOpenAPIPath(tokens []Token) string {
-	var b strings.Builder
-	for _, token := range tokens {
-		switch token.Type {
-		case TokenSlash:
-			b.WriteByte('/')
-		case TokenEOF:
-		case TokenColon:
-			b.WriteByte(':')
-		case TokenLiteral:
-			b.WriteString(token.Value)
-		case TokenIdent:
-			b.WriteString(token.Value)
-		case TokenVariable:
-			// Handle the name= prefix by extracting just the path portion
-			if strings.Contains(token.Value, "=") {
-				matches := namedPathPattern.FindStringSubmatch("{" + token.Value + "}")
-				if len(matches) == 3 {
-					// Add the "name=" "name" value to the list of covered parameters.
-					// Convert the path from the starred form to use named path parameters.
-					starredPath := matches[2]
-					parts := strings.Split(starredPath, "/")
-					// The starred path is assumed to be in the form "things/*/otherthings/*".
-					// We want to convert it to "things/{thingsId}/otherthings/{otherthingsId}".
-					for i := 0; i < len(parts)-1; i += 2 {
-						section := parts[i]
-						namedPathParameter := util.Singular(section)
-						parts[i+1] = "{" + namedPathParameter + "}"
-					}
-					// Rewrite the path to use the path parameters.
-					newPath := strings.Join(parts, "/")
-					b.WriteString(newPath)
-				} else {
-					b.WriteByte('{')
-					b.WriteString(token.Value)
-					b.WriteByte('}')
-				}
-			} else {
-				b.WriteByte('{')
-				b.WriteString(token.Value)
-				b.WriteByte('}')
-			}
-		}
-	}
-	return b.String()
+func flattenToParams(opts options.Options, md protoreflect.MessageDescriptor, prefix string, seen map[string]struct{}) []*v3.Parameter {
+	return flattenMessageToParams(opts, md, prefix, seen, []protoreflect.FullName{md.FullName()})
 }
 
-func flattenToParams(opts options.Options, md protoreflect.MessageDescriptor, prefix string, seen map[string]struct{}) []*v3.Parameter {
+// flattenMessageToParams turns the fields of a message into query parameters. Nested messages are flattened into
+// dotted names; parents holds the messages currently being flattened so recursive messages terminate.
+func flattenMessageToParams(opts options.Options, md protoreflect.MessageDescriptor, prefix string, seen map[string]struct{}, parents []protoreflect.FullName) []*v3.Parameter {
 	params := []*v3.Parameter{}
 	fields := md.Fields()
 	for i := 0; i < fields.Len(); i++ {
 		field := fields.Get(i)
+		if !util.IsVisible(opts, field) {
+			continue
+		}
 		paramName := prefix + util.MakeFieldName(opts, field)
 		// exclude fields already found in the path
 		if _, ok := seen[string(field.FullName())]; ok {
@@ -464,26 +477,189 @@ func flattenToParams(opts options.Options, md protoreflect.MessageDescriptor, pr
 		if _, ok := seen[paramName]; ok {
 			continue
 		}
-		seen[string(field.FullName())] = struct{}{}
-		switch field.Kind() {
-		case protoreflect.MessageKind:
-			params = append(params, flattenToParams(opts, field.Message(), paramName+".", seen)...)
+		switch {
+		case field.IsMap(), field.Kind() != protoreflect.MessageKind:
+			params = append(params, fieldToQueryParam(opts, field, paramName))
+		case isQueryScalarMessage(field.Message()):
+			params = append(params, fieldToQueryParam(opts, field, paramName))
+		case !isFlattenable(opts, field, parents):
+			params = append(params, fieldToJSONQueryParam(opts, field, paramName))
 		default:
-			parent := &base.Schema{}
-			schema := schema.FieldToSchema(opts, base.CreateSchemaProxy(parent), field)
-			var required *bool
-			if len(parent.Required) > 0 {
-				required = util.BoolPtr(true)
-			}
-			loc := field.ParentFile().SourceLocations().ByDescriptor(field)
-			params = append(params, &v3.Parameter{
-				Name:        paramName,
-				In:          "query",
-				Description: util.FormatComments(loc),
-				Schema:      schema,
-				Required:    required,
-			})
+			params = append(params, flattenMessageToParams(opts, field.Message(), paramName+".", seen, append(parents, field.Message().FullName()))...)
 		}
 	}
 	return params
 }
+
+// isQueryScalarMessage reports if a message is a well-known type that has a single string, number or boolean JSON form.
+func isQueryScalarMessage(md protoreflect.MessageDescriptor) bool {
+	switch md.FullName() {
+	case "google.protobuf.Timestamp", "google.protobuf.Duration", "google.protobuf.FieldMask":
+		return true
+	}
+	return isWrapper(md)
+}
+
+// isWrapper reports if a message is one of the google.protobuf wrapper types, which are written as their value.
+func isWrapper(md protoreflect.MessageDescriptor) bool {
+	switch md.FullName() {
+	case "google.protobuf.StringValue", "google.protobuf.BytesValue", "google.protobuf.BoolValue",
+		"google.protobuf.DoubleValue", "google.protobuf.FloatValue",
+		"google.protobuf.Int32Value", "google.protobuf.UInt32Value",
+		"google.protobuf.Int64Value", "google.protobuf.UInt64Value":
+		return true
+	}
+	return false
+}
+
+// isFlattenable reports if a message field can be expanded into one query parameter per nested field.
+func isFlattenable(opts options.Options, field protoreflect.FieldDescriptor, parents []protoreflect.FullName) bool {
+	if field.IsList() || util.IsWellKnown(field.Message()) {
+		return false
+	}
+	if fieldOpts := util.GetFieldOptions(field); fieldOpts != nil && fieldOpts.GetNoFlatten() {
+		return false
+	}
+	if opts.QueryParamsMaxDepth > 0 && len(parents) > opts.QueryParamsMaxDepth {
+		return false
+	}
+	return !slices.Contains(parents, field.Message().FullName())
+}
+
+// fieldToQueryParam documents a field the way grpc-gateway decodes it from a query string: repeated fields as
+// repeated keys (?tag=a&tag=b), maps as name[key]=value and FieldMasks as one comma-separated list of paths.
+func fieldToQueryParam(opts options.Options, field protoreflect.FieldDescriptor, name string) *v3.Parameter {
+	parent := &base.Schema{}
+	schema := queryParamSchema(opts, base.CreateSchemaProxy(parent), field)
+	var required *bool
+	if len(parent.Required) > 0 {
+		required = util.BoolPtr(true)
+	}
+	var style string
+	var explode *bool
+	switch {
+	case field.IsMap():
+		style, explode = "deepObject", util.BoolPtr(true)
+	case field.IsList():
+		style, explode = "form", util.BoolPtr(true)
+	case field.Kind() == protoreflect.MessageKind && field.Message().FullName() == "google.protobuf.FieldMask":
+		style, explode = "form", util.BoolPtr(false)
+	}
+	loc := field.ParentFile().SourceLocations().ByDescriptor(field)
+	return &v3.Parameter{
+		Name:        name,
+		In:          "query",
+		Description: util.FormatComments(opts, loc),
+		Style:       style,
+		Explode:     explode,
+		Schema:      schema,
+		Required:    required,
+	}
+}
+
+// queryParamSchema returns the schema of a field as written in a query string. Enums and well-known types are
+// described inline because their query form differs from the JSON schema of their components.
+func queryParamSchema(opts options.Options, parent *base.SchemaProxy, field protoreflect.FieldDescriptor) *base.SchemaProxy {
+	switch {
+	case field.IsMap():
+		return schema.FieldToSchema(opts, parent, field)
+	case field.Kind() == protoreflect.MessageKind && !isQueryScalarMessage(field.Message()):
+		return schema.FieldToSchema(opts, parent, field)
+	case field.Kind() != protoreflect.EnumKind && field.Kind() != protoreflect.MessageKind:
+		return schema.FieldToSchema(opts, parent, field)
+	}
+	if !field.IsList() {
+		return base.CreateSchemaProxy(queryItemSchema(opts, parent, field, false))
+	}
+	s := schema.FieldToSchema(opts, parent, field).Schema()
+	s.Items = &base.DynamicValue[*base.SchemaProxy, bool]{A: base.CreateSchemaProxy(queryItemSchema(opts, parent, field, true))}
+	return base.CreateSchemaProxy(s)
+}
+
+func queryItemSchema(opts options.Options, parent *base.SchemaProxy, field protoreflect.FieldDescriptor, inContainer bool) *base.Schema {
+	s := schema.ScalarFieldToSchema(opts, parent, field, inContainer)
+	if field.Kind() == protoreflect.EnumKind {
+		// Enums are accepted by name or by number. Keep values already narrowed down by annotations, in their
+		// query string form.
+		s.Type = []string{"string"}
+		for i, value := range s.Enum {
+			s.Enum[i] = utils.CreateStringNode(value.Value)
+		}
+		if len(s.Enum) == 0 {
+			values := util.EnumValues(field.Enum(), opts.OmitEnumUnspecified || rejectsEnumZero(s))
+			for _, value := range values {
+				s.Enum = append(s.Enum, utils.CreateStringNode(string(value.Name())))
+			}
+			for _, value := range values {
+				s.Enum = append(s.Enum, utils.CreateStringNode(strconv.FormatInt(int64(value.Number()), 10)))
+			}
+			if opts.TrimEnumPrefix {
+				labels := make([]*yaml.Node, 0, len(values))
+				for _, value := range values {
+					labels = append(labels, utils.CreateStringNode(util.EnumValueLabel(opts, value)))
+				}
+				// The labels of the names, then of the numbers, in the order of enum.
+				varnames := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq", Content: append(labels, labels...)}
+				if s.Extensions == nil {
+					s.Extensions = orderedmap.New[string, *yaml.Node]()
+				}
+				s.Extensions.Set("x-enum-varnames", varnames)
+			}
+		}
+		return s
+	}
+
+	md := field.Message()
+	switch md.FullName() {
+	case "google.protobuf.Timestamp":
+		s.Type = []string{"string"}
+		s.Format = "date-time"
+	case "google.protobuf.Duration":
+		s.Type = []string{"string"}
+		s.Pattern = `^-?[0-9]+(\.[0-9]{1,9})?s$`
+	case "google.protobuf.FieldMask":
+		s.Type = []string{"array"}
+		s.Items = &base.DynamicValue[*base.SchemaProxy, bool]{A: base.CreateSchemaProxy(&base.Schema{Type: []string{"string"}})}
+	default:
+		value := schema.ScalarFieldToSchema(opts, nil, md.Fields().ByName("value"), true)
+		s.Type = value.Type
+		s.Format = value.Format
+		s.Pattern = value.Pattern
+		s.Minimum, s.Maximum = value.Minimum, value.Maximum
+		s.ExclusiveMinimum, s.ExclusiveMaximum = value.ExclusiveMinimum, value.ExclusiveMaximum
+		s.Nullable = util.BoolPtr(true)
+	}
+	return s
+}
+
+// rejectsEnumZero reports if the protovalidate rules of an enum field, in a not_in list, reject its zero value.
+func rejectsEnumZero(s *base.Schema) bool {
+	if s.Not == nil || s.Not.Schema() == nil {
+		return false
+	}
+	for _, value := range s.Not.Schema().Enum {
+		if value.Value == "0" {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldToJSONQueryParam documents a message field that isn't flattened as a single parameter holding its JSON encoding.
+func fieldToJSONQueryParam(opts options.Options, field protoreflect.FieldDescriptor, name string) *v3.Parameter {
+	parent := &base.Schema{}
+	content := orderedmap.New[string, *v3.MediaType]()
+	content.Set("application/json", &v3.MediaType{Schema: schema.FieldToSchema(opts, base.CreateSchemaProxy(parent), field)})
+	var required *bool
+	if len(parent.Required) > 0 {
+		required = util.BoolPtr(true)
+	}
+	loc := field.ParentFile().SourceLocations().ByDescriptor(field)
+	return &v3.Parameter{
+		Name:        name,
+		In:          "query",
+		Description: util.FormatComments(opts, loc),
+		Content:     content,
+		Required:    required,
+	}
+}
@@ -5,9 +5,13 @@ import (
 
 	"github.com/pb33f/libopenapi/datamodel/high/base"
 	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pubgo/protoc-gen-openapi/internal/converter/options"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/api/annotations"
 	"google.golang.org/protobuf/proto" // For proto.Bool
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
 	"gopkg.in/yaml.v3"
 )
 
@@ -172,14 +176,115 @@ func TestPartsToOpenAPIPath(t *testing.T) {
 	t.Run("with annotation", func(t *testing.T) {
 		v, err := RunPathPatternLexer("/pet/{pet_id}:addPet")
 		require.NoError(t, err)
-		path := partsToOpenAPIPath(v)
+		path := partsToOpenAPIPath(options.Options{}, nil, v)
 		assert.Equal(t, "/pet/{pet_id}:addPet", path)
 	})
 
 	t.Run("with glob pattern", func(t *testing.T) {
 		v, err := RunPathPatternLexer("/users/v1/{name=organizations/*/teams/*/members/*}:activate")
 		require.NoError(t, err)
-		path := partsToOpenAPIPath(v)
+		path := partsToOpenAPIPath(options.Options{}, nil, v)
 		assert.Equal(t, "/users/v1/organizations/{organization}/teams/{team}/members/{member}:activate", path)
 	})
+
+	t.Run("with multi-segment wildcards", func(t *testing.T) {
+		v, err := RunPathPatternLexer("/v1/{name=**}")
+		require.NoError(t, err)
+		path, params := renderPathTemplate(options.Options{}, nil, v)
+		assert.Equal(t, "/v1/{name}", path)
+		assert.Equal(t, []pathParam{{Name: "name", FieldPath: "name", Multi: true}}, params)
+
+		v, err = RunPathPatternLexer("/v1/{name=files/**}")
+		require.NoError(t, err)
+		path, params = renderPathTemplate(options.Options{}, nil, v)
+		assert.Equal(t, "/v1/files/{file}", path)
+		assert.Equal(t, []pathParam{{Name: "file", FieldPath: "name", Segment: true, Multi: true}}, params)
+	})
+
+	t.Run("with wildcards outside of variables", func(t *testing.T) {
+		v, err := RunPathPatternLexer("/v1/shelves/*/books/{book}")
+		require.NoError(t, err)
+		path, params := renderPathTemplate(options.Options{}, nil, v)
+		assert.Equal(t, "/v1/shelves/{shelf}/books/{book}", path)
+		assert.Equal(t, []pathParam{{Name: "shelf", Segment: true}, {Name: "book", FieldPath: "book"}}, params)
+	})
+
+	t.Run("with custom verbs", func(t *testing.T) {
+		v, err := RunPathPatternLexer("/v1/{name=projects/*/locations/*}:customVerb")
+		require.NoError(t, err)
+		assert.Equal(t, "/v1/projects/{project}/locations/{location}:customVerb", partsToOpenAPIPath(options.Options{}, nil, v))
+		assert.Equal(t, "/v1/projects/{project}/locations/{location}%3AcustomVerb", partsToOpenAPIPath(options.Options{EscapeVerbColons: true}, nil, v))
+	})
+
+	t.Run("with nested field paths", func(t *testing.T) {
+		v, err := RunPathPatternLexer("/v1/{book.name}/{book.author.id}")
+		require.NoError(t, err)
+		assert.Equal(t, "/v1/{bookName}/{bookAuthorId}", partsToOpenAPIPath(options.Options{}, nil, v))
+		assert.Equal(t, "/v1/{book_name}/{book_author_id}", partsToOpenAPIPath(options.Options{WithProtoNames: true}, nil, v))
+	})
+
+	t.Run("with irregular plurals", func(t *testing.T) {
+		v, err := RunPathPatternLexer("/v1/{name=people/*/addresses/*/statuses/*}")
+		require.NoError(t, err)
+		path := partsToOpenAPIPath(options.Options{}, nil, v)
+		assert.Equal(t, "/v1/people/{person}/addresses/{address}/statuses/{status}", path)
+	})
+
+	t.Run("with singular overrides", func(t *testing.T) {
+		v, err := RunPathPatternLexer("/v1/{name=octopi/*/staff/*}")
+		require.NoError(t, err)
+		path := partsToOpenAPIPath(options.Options{Singulars: map[string]string{"octopi": "octopus", "staff": "staffMember"}}, nil, v)
+		assert.Equal(t, "/v1/octopi/{octopus}/staff/{staffMember}", path)
+	})
+}
+
+func TestResourcePatterns(t *testing.T) {
+	t.Run("pattern regexp", func(t *testing.T) {
+		assert.Equal(t, `^people/[^/]+$`, resourcePatternRegexp([]string{"people/{person}"}))
+		assert.Equal(t, `^(people/[^/]+/addresses/[^/]+|organizations/[^/]+/addresses/[^/]+)$`,
+			resourcePatternRegexp([]string{"people/{person}/addresses/{address}", "organizations/{organization}/addresses/{address}"}))
+	})
+
+	t.Run("parent patterns", func(t *testing.T) {
+		assert.Equal(t, []string{"people/{person}", "people/{person}/addresses/{address}"},
+			parentPatterns([]string{"people/{person}/addresses/{address}", "people/{person}/addresses/{address}/lines/{line}"}))
+		assert.Empty(t, parentPatterns([]string{"people/{person}"}))
+	})
+
+	t.Run("variable names", func(t *testing.T) {
+		pattern := []string{"people", "{person}", "addresses", "{address}"}
+		assert.Equal(t, []string{"person", "address"}, matchPatternSegments(pattern, []string{"people", "*", "addresses", "*"}))
+		assert.Nil(t, matchPatternSegments(pattern, []string{"organizations", "*", "addresses", "*"}))
+		assert.Nil(t, matchPatternSegments(pattern, []string{"people", "*"}))
+	})
+}
+
+func TestMethodPaths(t *testing.T) {
+	methodOpts := &descriptorpb.MethodOptions{}
+	proto.SetExtension(methodOpts, annotations.E_Http, &annotations.HttpRule{
+		Pattern: &annotations.HttpRule_Custom{Custom: &annotations.CustomHttpPattern{Kind: "PURGE", Path: "/v1/caches/{name}"}},
+		AdditionalBindings: []*annotations.HttpRule{
+			{Pattern: &annotations.HttpRule_Delete{Delete: "/v1/{name=caches/*}"}},
+		},
+	})
+	fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:        proto.String("caches.proto"),
+		Package:     proto.String("caches"),
+		Syntax:      proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{{Name: proto.String("Cache")}},
+		Service: []*descriptorpb.ServiceDescriptorProto{{
+			Name: proto.String("Caches"),
+			Method: []*descriptorpb.MethodDescriptorProto{{
+				Name:       proto.String("PurgeCache"),
+				InputType:  proto.String(".caches.Cache"),
+				OutputType: proto.String(".caches.Cache"),
+				Options:    methodOpts,
+			}},
+		}},
+	}, nil)
+	require.NoError(t, err)
+	md := fd.Services().Get(0).Methods().Get(0)
+
+	assert.Equal(t, []string{"/v1/caches/{name}", "/v1/caches/{cache}"}, MethodPaths(options.Options{}, md))
+	assert.Empty(t, MethodPaths(options.Options{IgnoreGoogleapiHTTP: true}, md))
 }
@@ -0,0 +1,298 @@
+package googleapi
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"github.com/pb33f/libopenapi/utils"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"gopkg.in/yaml.v3"
+
+	"github.com/pubgo/protoc-gen-openapi/internal/converter/options"
+)
+
+// resourceVariable matches a variable of a resource name pattern, like {book} in shelves/{shelf}/books/{book}.
+var resourceVariable = regexp.MustCompile(`\{([^}]+)\}`)
+
+// GetResource returns the google.api.resource option of a message, or nil if it isn't a resource.
+func GetResource(md protoreflect.MessageDescriptor) *annotations.ResourceDescriptor {
+	mdopts := md.Options()
+	if mdopts == nil || !proto.HasExtension(mdopts, annotations.E_Resource) {
+		return nil
+	}
+	resource, ok := proto.GetExtension(mdopts, annotations.E_Resource).(*annotations.ResourceDescriptor)
+	if !ok {
+		return nil
+	}
+	return resource
+}
+
+// GetResourceReference returns the google.api.resource_reference option of a field, or nil if it has none.
+func GetResourceReference(field protoreflect.FieldDescriptor) *annotations.ResourceReference {
+	fopts := field.Options()
+	if fopts == nil || !proto.HasExtension(fopts, annotations.E_ResourceReference) {
+		return nil
+	}
+	ref, ok := proto.GetExtension(fopts, annotations.E_ResourceReference).(*annotations.ResourceReference)
+	if !ok {
+		return nil
+	}
+	return ref
+}
+
+// findResource looks up a resource type in the messages and google.api.resource_definition options of a file
+// and everything it imports.
+func findResource(fd protoreflect.FileDescriptor, typ string) *annotations.ResourceDescriptor {
+	return findResourceInFile(fd, typ, map[string]struct{}{})
+}
+
+func findResourceInFile(fd protoreflect.FileDescriptor, typ string, seen map[string]struct{}) *annotations.ResourceDescriptor {
+	if _, ok := seen[fd.Path()]; ok {
+		return nil
+	}
+	seen[fd.Path()] = struct{}{}
+
+	if fdopts := fd.Options(); fdopts != nil && proto.HasExtension(fdopts, annotations.E_ResourceDefinition) {
+		definitions, _ := proto.GetExtension(fdopts, annotations.E_ResourceDefinition).([]*annotations.ResourceDescriptor)
+		for _, resource := range definitions {
+			if resource.GetType() == typ {
+				return resource
+			}
+		}
+	}
+	if resource := findResourceInMessages(fd.Messages(), typ); resource != nil {
+		return resource
+	}
+
+	imports := fd.Imports()
+	for i := 0; i < imports.Len(); i++ {
+		if resource := findResourceInFile(imports.Get(i).FileDescriptor, typ, seen); resource != nil {
+			return resource
+		}
+	}
+	return nil
+}
+
+func findResourceInMessages(messages protoreflect.MessageDescriptors, typ string) *annotations.ResourceDescriptor {
+	for i := 0; i < messages.Len(); i++ {
+		md := messages.Get(i)
+		if resource := GetResource(md); resource.GetType() == typ {
+			return resource
+		}
+		if resource := findResourceInMessages(md.Messages(), typ); resource != nil {
+			return resource
+		}
+	}
+	return nil
+}
+
+// fieldResourcePatterns returns the resource name patterns a string field holds: the patterns of its own
+// resource for the name field of a resource message, of the referenced resource for a resource_reference, and
+// of the parents of the child resource for a child_type reference. The resource type is returned as well.
+func fieldResourcePatterns(field protoreflect.FieldDescriptor) (typ string, child bool, patterns []string) {
+	if field.Kind() != protoreflect.StringKind {
+		return "", false, nil
+	}
+	if ref := GetResourceReference(field); ref != nil {
+		switch {
+		case ref.GetType() == "*" || ref.GetChildType() == "*":
+			return "*", false, nil
+		case ref.GetType() != "":
+			return ref.GetType(), false, findResource(field.ParentFile(), ref.GetType()).GetPattern()
+		case ref.GetChildType() != "":
+			return ref.GetChildType(), true, parentPatterns(findResource(field.ParentFile(), ref.GetChildType()).GetPattern())
+		}
+	}
+	if resource := GetResource(field.ContainingMessage()); resource != nil {
+		nameField := resource.GetNameField()
+		if nameField == "" {
+			nameField = "name"
+		}
+		if string(field.Name()) == nameField {
+			return resource.GetType(), false, resource.GetPattern()
+		}
+	}
+	return "", false, nil
+}
+
+// parentPatterns strips the last collection and id from each pattern, leaving the patterns of the parents.
+func parentPatterns(patterns []string) []string {
+	var parents []string
+	for _, pattern := range patterns {
+		segments := strings.Split(pattern, "/")
+		if len(segments) < 3 {
+			continue
+		}
+		parents = append(parents, strings.Join(segments[:len(segments)-2], "/"))
+	}
+	return slices.Compact(parents)
+}
+
+// resourcePatternRegexp turns resource name patterns into one regular expression matching any of them.
+func resourcePatternRegexp(patterns []string) string {
+	alternatives := make([]string, 0, len(patterns))
+	for _, pattern := range patterns {
+		var b strings.Builder
+		last := 0
+		for _, loc := range resourceVariable.FindAllStringIndex(pattern, -1) {
+			b.WriteString(regexp.QuoteMeta(pattern[last:loc[0]]))
+			b.WriteString("[^/]+")
+			last = loc[1]
+		}
+		b.WriteString(regexp.QuoteMeta(pattern[last:]))
+		alternatives = append(alternatives, b.String())
+	}
+	if len(alternatives) == 1 {
+		return "^" + alternatives[0] + "$"
+	}
+	return "^(" + strings.Join(alternatives, "|") + ")$"
+}
+
+// SchemaWithResourceAnnotations adds the name pattern and x-resource-type of resource names to string fields.
+func SchemaWithResourceAnnotations(opts options.Options, schema *base.Schema, desc protoreflect.FieldDescriptor) *base.Schema {
+	typ, child, patterns := fieldResourcePatterns(desc)
+	if typ == "" {
+		return schema
+	}
+	// The pattern of a repeated field applies to its items.
+	target := schema
+	if desc.IsList() && schema.Items != nil && schema.Items.IsA() && schema.Items.A != nil && schema.Items.A.Schema() != nil {
+		target = schema.Items.A.Schema()
+	}
+	if !slices.Contains(target.Type, "string") {
+		return schema
+	}
+	if len(patterns) > 0 && target.Pattern == "" {
+		target.Pattern = resourcePatternRegexp(patterns)
+	}
+	key := "x-resource-type"
+	if child {
+		key = "x-resource-child-type"
+	}
+	if target.Extensions == nil {
+		target.Extensions = orderedmap.New[string, *yaml.Node]()
+	}
+	target.Extensions.Set(key, utils.CreateStringNode(typ))
+	return schema
+}
+
+// SchemaWithResourceMessageAnnotations adds x-resource-type and the name patterns of resource messages.
+func SchemaWithResourceMessageAnnotations(opts options.Options, schema *base.Schema, desc protoreflect.MessageDescriptor) *base.Schema {
+	resource := GetResource(desc)
+	if resource == nil || resource.GetType() == "" {
+		return schema
+	}
+	if schema.Extensions == nil {
+		schema.Extensions = orderedmap.New[string, *yaml.Node]()
+	}
+	schema.Extensions.Set("x-resource-type", utils.CreateStringNode(resource.GetType()))
+	if len(resource.GetPattern()) > 0 {
+		patterns := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+		for _, pattern := range resource.GetPattern() {
+			patterns.Content = append(patterns.Content, utils.CreateStringNode(pattern))
+		}
+		schema.Extensions.Set("x-resource-patterns", patterns)
+	}
+	return schema
+}
+
+// pathVariableNames names the starred segments of a path variable such as {name=shelves/*/books/*} after the
+// variables of the matching resource name pattern, shelves/{shelf}/books/{book}. It returns nil if the field
+// has no resource pattern with the same shape.
+func pathVariableNames(input protoreflect.MessageDescriptor, fieldPath string, segments []string) []string {
+	if input == nil {
+		return nil
+	}
+	field, _ := resolveField(input, fieldPath)
+	if field == nil {
+		return nil
+	}
+	_, _, patterns := fieldResourcePatterns(field)
+	for _, pattern := range patterns {
+		if names := matchPatternSegments(strings.Split(pattern, "/"), segments); names != nil {
+			return names
+		}
+	}
+	return nil
+}
+
+// matchPatternSegments returns the variable names of a resource pattern for each starred segment, or nil if
+// the literal segments of the pattern and the path differ.
+func matchPatternSegments(patternSegments, segments []string) []string {
+	if len(patternSegments) != len(segments) {
+		return nil
+	}
+	var names []string
+	for i, segment := range segments {
+		variable := resourceVariable.FindStringSubmatch(patternSegments[i])
+		switch {
+		case segment == "*" && variable != nil && variable[0] == patternSegments[i]:
+			names = append(names, variable[1])
+		case segment != patternSegments[i]:
+			return nil
+		}
+	}
+	return names
+}
+
+// resourceSingulars maps the plural of each resource known to a file to its singular, as given by the plural
+// and singular fields of google.api.resource or else by the last collection and variable of its pattern.
+func resourceSingulars(fd protoreflect.FileDescriptor) map[string]string {
+	singulars := map[string]string{}
+	addResourceSingulars(fd, singulars, map[string]struct{}{})
+	return singulars
+}
+
+func addResourceSingulars(fd protoreflect.FileDescriptor, singulars map[string]string, seen map[string]struct{}) {
+	if _, ok := seen[fd.Path()]; ok {
+		return
+	}
+	seen[fd.Path()] = struct{}{}
+
+	if fdopts := fd.Options(); fdopts != nil && proto.HasExtension(fdopts, annotations.E_ResourceDefinition) {
+		definitions, _ := proto.GetExtension(fdopts, annotations.E_ResourceDefinition).([]*annotations.ResourceDescriptor)
+		for _, resource := range definitions {
+			addResourceSingular(resource, singulars)
+		}
+	}
+	var walk func(messages protoreflect.MessageDescriptors)
+	walk = func(messages protoreflect.MessageDescriptors) {
+		for i := 0; i < messages.Len(); i++ {
+			if resource := GetResource(messages.Get(i)); resource != nil {
+				addResourceSingular(resource, singulars)
+			}
+			walk(messages.Get(i).Messages())
+		}
+	}
+	walk(fd.Messages())
+
+	imports := fd.Imports()
+	for i := 0; i < imports.Len(); i++ {
+		addResourceSingulars(imports.Get(i).FileDescriptor, singulars, seen)
+	}
+}
+
+func addResourceSingular(resource *annotations.ResourceDescriptor, singulars map[string]string) {
+	if resource.GetPlural() != "" && resource.GetSingular() != "" {
+		singulars[resource.GetPlural()] = resource.GetSingular()
+	}
+	for _, pattern := range resource.GetPattern() {
+		segments := strings.Split(pattern, "/")
+		if len(segments) < 2 {
+			continue
+		}
+		variable := resourceVariable.FindStringSubmatch(segments[len(segments)-1])
+		collection := segments[len(segments)-2]
+		if variable == nil || variable[0] != segments[len(segments)-1] || resourceVariable.MatchString(collection) {
+			continue
+		}
+		if _, ok := singulars[collection]; !ok {
+			singulars[collection] = variable[1]
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package googleapi
+
+import (
+	"slices"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/pubgo/protoc-gen-openapi/internal/converter/options"
+	"github.com/pubgo/protoc-gen-openapi/internal/converter/schema"
+	"github.com/pubgo/protoc-gen-openapi/internal/converter/util"
+)
+
+// responseHeaderFields returns the top-level fields of a response message that the openapi.v3.field option sends
+// as response headers.
+func responseHeaderFields(md protoreflect.MessageDescriptor) []protoreflect.FieldDescriptor {
+	var headers []protoreflect.FieldDescriptor
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		if util.GetFieldOptions(fields.Get(i)).GetResponseHeader() != "" {
+			headers = append(headers, fields.Get(i))
+		}
+	}
+	return headers
+}
+
+// ResponseHeaders documents the fields of a response message sent as HTTP response headers, with the schema
+// and comments of each field. It returns nil if the message has none.
+func ResponseHeaders(opts options.Options, md protoreflect.MessageDescriptor) *orderedmap.Map[string, *v3.Header] {
+	fields := responseHeaderFields(md)
+	if len(fields) == 0 {
+		return nil
+	}
+	headers := orderedmap.New[string, *v3.Header]()
+	for _, field := range fields {
+		if !util.IsVisible(opts, field) {
+			continue
+		}
+		parent := &base.Schema{}
+		header := &v3.Header{
+			Description: util.FormatComments(opts, field.ParentFile().SourceLocations().ByDescriptor(field)),
+			Schema:      queryParamSchema(opts, base.CreateSchemaProxy(parent), field),
+		}
+		header.Required = len(parent.Required) > 0
+		headers.Set(util.GetFieldOptions(field).GetResponseHeader(), header)
+	}
+	if headers.Len() == 0 {
+		return nil
+	}
+	return headers
+}
+
+// ResponseBodySchema returns the schema of a response message sent as the HTTP response body. Messages with
+// fields sent as headers get an inline schema without them, the others a reference to their component.
+func ResponseBodySchema(opts options.Options, md protoreflect.MessageDescriptor) *base.SchemaProxy {
+	ref := base.CreateSchemaProxyRef("#/components/schemas/" + util.FormatTypeRef(string(md.FullName())))
+	fields := responseHeaderFields(md)
+	if len(fields) == 0 {
+		return ref
+	}
+	_, s := schema.MessageToSchema(opts, md)
+	if s == nil || s.Properties == nil {
+		return ref
+	}
+	for _, field := range fields {
+		deleteProperty(s, util.MakeFieldName(opts, field))
+	}
+	return base.CreateSchemaProxy(s)
+}
+
+// deleteProperty removes a property from an object schema and from its required properties.
+func deleteProperty(s *base.Schema, name string) {
+	s.Properties.Delete(name)
+	if s.Required != nil {
+		s.Required = slices.DeleteFunc(s.Required, func(s string) bool {
+			return s == name
+		})
+		// don't serialize []
+		if len(s.Required) == 0 {
+			s.Required = nil
+		}
+	}
+}
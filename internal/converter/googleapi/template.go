@@ -0,0 +1,162 @@
+package googleapi
+
+import (
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/pubgo/protoc-gen-openapi/internal/converter/options"
+	"github.com/pubgo/protoc-gen-openapi/internal/converter/util"
+)
+
+// pathParam is a parameter of the OpenAPI path rendered from an HTTP rule template.
+type pathParam struct {
+	Name string
+	// FieldPath is the request field the parameter is bound to, empty for a wildcard outside of a variable.
+	FieldPath string
+	// Segment is set when the parameter is one wildcard of a variable like {name=shelves/*/books/*}, so it only
+	// holds a part of the field.
+	Segment bool
+	// Multi is set for a ** wildcard, which matches any number of segments including their slashes.
+	Multi bool
+}
+
+// renderPathTemplate renders the tokens of an HTTP rule template as an OpenAPI path and returns the path
+// parameters in order. Variables bound to a single wildcard, {name} or {name=*}, become one parameter named
+// after the field. The wildcards of longer variables, {name=shelves/*/books/*}, become one parameter each,
+// named after the resource pattern of the field in input when it has one, and otherwise after the singular
+// of the collection before them. A ** wildcard becomes a single parameter that may contain slashes. The :verb
+// suffix is kept and its colon is percent-encoded when opts.EscapeVerbColons is set.
+func renderPathTemplate(opts options.Options, input protoreflect.MessageDescriptor, tokens []Token) (string, []pathParam) {
+	var b strings.Builder
+	var params []pathParam
+	used := map[string]int{}
+	unique := func(name string) string {
+		used[name]++
+		if used[name] == 1 {
+			return name
+		}
+		return name + strconv.Itoa(used[name])
+	}
+
+	var previous string
+	for _, token := range tokens {
+		switch token.Type {
+		case TokenSlash:
+			b.WriteByte('/')
+		case TokenEOF:
+		case TokenColon:
+			if opts.EscapeVerbColons {
+				b.WriteString("%3A")
+			} else {
+				b.WriteByte(':')
+			}
+		case TokenLiteral:
+			// A wildcard outside of a variable matches a segment that isn't bound to any field.
+			name := "segment"
+			if previous != "" {
+				name = util.Singular(previous, opts.Singulars)
+			}
+			name = unique(name)
+			params = append(params, pathParam{Name: name, Segment: true, Multi: token.Value == "**"})
+			b.WriteString("{" + name + "}")
+		case TokenIdent:
+			b.WriteString(token.Value)
+		case TokenVariable:
+			fieldPath, segments, _ := strings.Cut(token.Value, "=")
+			if segments == "" || segments == "*" || segments == "**" {
+				name := unique(pathParamName(opts, input, fieldPath))
+				params = append(params, pathParam{Name: name, FieldPath: fieldPath, Multi: segments == "**"})
+				b.WriteString("{" + name + "}")
+				break
+			}
+			parts, names := namedPathSegments(opts, input, fieldPath, segments)
+			for i, name := range names {
+				names[i] = unique(name)
+			}
+			next := 0
+			for i, part := range parts {
+				if part != "*" && part != "**" {
+					continue
+				}
+				params = append(params, pathParam{Name: names[next], FieldPath: fieldPath, Segment: true, Multi: part == "**"})
+				parts[i] = "{" + names[next] + "}"
+				next++
+			}
+			b.WriteString(strings.Join(parts, "/"))
+		}
+		switch token.Type {
+		case TokenSlash:
+		case TokenIdent:
+			previous = token.Value
+		default:
+			previous = ""
+		}
+	}
+	return b.String(), params
+}
+
+// pathParamName is the parameter name of a variable bound to a whole field. Top-level fields keep the name used
+// in the template, nested field paths like book.name become bookName, or book_name with proto names.
+func pathParamName(opts options.Options, input protoreflect.MessageDescriptor, fieldPath string) string {
+	if !strings.Contains(fieldPath, ".") {
+		return fieldPath
+	}
+	names := strings.Split(fieldPath, ".")
+	if input != nil {
+		current := input
+		for i, name := range names {
+			if current == nil {
+				break
+			}
+			field := fieldByName(current, name)
+			if field == nil {
+				break
+			}
+			names[i] = util.MakeFieldName(opts, field)
+			current = field.Message()
+		}
+	}
+	if opts.WithProtoNames {
+		return strings.Join(names, "_")
+	}
+	for i := 1; i < len(names); i++ {
+		if names[i] != "" {
+			names[i] = strings.ToUpper(names[i][:1]) + names[i][1:]
+		}
+	}
+	return strings.Join(names, "")
+}
+
+// namedPathSegments splits the segments of a variable like {name=shelves/*/books/*} and names its wildcards.
+// Names come from the field's resource pattern when there is one, otherwise from the singular form of the
+// collection before each wildcard, falling back to the name of the field itself.
+func namedPathSegments(opts options.Options, input protoreflect.MessageDescriptor, fieldPath, starredPath string) ([]string, []string) {
+	parts := strings.Split(starredPath, "/")
+	if names := pathVariableNames(input, fieldPath, parts); names != nil {
+		return parts, names
+	}
+
+	singulars := opts.Singulars
+	if input != nil {
+		singulars = resourceSingulars(input.ParentFile())
+		for plural, singular := range opts.Singulars {
+			singulars[plural] = singular
+		}
+	}
+	// The starred path is usually in the form "things/*/otherthings/*", which is named
+	// "things/{thing}/otherthings/{otherthing}".
+	var names []string
+	for i, part := range parts {
+		if part != "*" && part != "**" {
+			continue
+		}
+		if i > 0 && parts[i-1] != "*" && parts[i-1] != "**" {
+			names = append(names, util.Singular(parts[i-1], singulars))
+		} else {
+			names = append(names, pathParamName(opts, input, fieldPath))
+		}
+	}
+	return parts, names
+}
@@ -0,0 +1,115 @@
+package googleapi
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/utils"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/pubgo/protoc-gen-openapi/internal/converter/options"
+	"github.com/pubgo/protoc-gen-openapi/internal/converter/schema"
+	"github.com/pubgo/protoc-gen-openapi/internal/converter/util"
+)
+
+// PartialResources returns the resources that AIP-134 style update methods send as their body together with
+// an update mask. Each of them gets a derived component where every field is optional.
+func PartialResources(opts options.Options, md protoreflect.MethodDescriptor) []protoreflect.MessageDescriptor {
+	if opts.IgnoreGoogleapiHTTP || !proto.HasExtension(md.Options(), annotations.E_Http) {
+		return nil
+	}
+	rule, ok := proto.GetExtension(md.Options(), annotations.E_Http).(*annotations.HttpRule)
+	if !ok {
+		return nil
+	}
+
+	var resources []protoreflect.MessageDescriptor
+	for _, r := range append([]*annotations.HttpRule{rule}, rule.AdditionalBindings...) {
+		method, _ := httpRulePattern(r)
+		body, _ := resolveField(md.Input(), r.Body)
+		if updateMaskField(method, md.Input(), body) == nil || slices.Contains(resources, body.Message()) {
+			continue
+		}
+		resources = append(resources, body.Message())
+	}
+	return resources
+}
+
+// PartialSchemaID is the component id of the partial form of a resource.
+func PartialSchemaID(md protoreflect.MessageDescriptor) string {
+	return util.FormatTypeRef(string(md.FullName())) + ".partial"
+}
+
+// PartialSchema is the schema of a resource sent with an update mask: only the fields named by the mask are
+// read, so none of them are required.
+func PartialSchema(opts options.Options, md protoreflect.MessageDescriptor) *base.Schema {
+	_, s := schema.MessageToSchema(opts, md)
+	if s == nil {
+		return nil
+	}
+	s.Required = nil
+	if s.Description != "" {
+		s.Description += "\n\n"
+	}
+	s.Description += "Partial " + string(md.Name()) + " for updates, only the fields listed in the update mask are applied."
+	return s
+}
+
+// updateMaskField returns the update_mask field of a PATCH or PUT update request whose body is the resource
+// field, or nil if the request doesn't follow that pattern. Other field masks, like a read_mask, don't make the
+// resource partial.
+func updateMaskField(method string, input protoreflect.MessageDescriptor, body protoreflect.FieldDescriptor) protoreflect.FieldDescriptor {
+	if method != http.MethodPatch && method != http.MethodPut {
+		return nil
+	}
+	if body == nil || body.Kind() != protoreflect.MessageKind || body.IsList() || body.IsMap() || util.IsWellKnown(body.Message()) {
+		return nil
+	}
+	if mask := input.Fields().ByName("update_mask"); mask != nil && isFieldMask(mask) {
+		return mask
+	}
+	return nil
+}
+
+func isFieldMask(field protoreflect.FieldDescriptor) bool {
+	return field.Kind() == protoreflect.MessageKind && !field.IsList() && field.Message().FullName() == "google.protobuf.FieldMask"
+}
+
+// updateMaskParam documents the update mask as a comma-separated list of the resource's field paths, or "*" to
+// replace the whole resource.
+func updateMaskParam(opts options.Options, mask protoreflect.FieldDescriptor, resource protoreflect.MessageDescriptor) *v3.Parameter {
+	param := fieldToQueryParam(opts, mask, util.MakeFieldName(opts, mask))
+	paths := fieldMaskPaths(opts, resource, "", []protoreflect.FullName{resource.FullName()})
+	items := &base.Schema{Type: []string{"string"}}
+	for _, path := range append(paths, "*") {
+		items.Enum = append(items.Enum, utils.CreateStringNode(path))
+	}
+	param.Schema.Schema().Items = &base.DynamicValue[*base.SchemaProxy, bool]{A: base.CreateSchemaProxy(items)}
+	return param
+}
+
+// fieldMaskPaths lists the paths a field mask can name in a message, including the fields of nested messages.
+func fieldMaskPaths(opts options.Options, md protoreflect.MessageDescriptor, prefix string, parents []protoreflect.FullName) []string {
+	var paths []string
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		if !util.IsVisible(opts, field) {
+			continue
+		}
+		path := prefix + util.MakeFieldName(opts, field)
+		paths = append(paths, path)
+		if field.Kind() != protoreflect.MessageKind || field.IsList() || field.IsMap() {
+			continue
+		}
+		if util.IsWellKnown(field.Message()) || isWrapper(field.Message()) || slices.Contains(parents, field.Message().FullName()) {
+			continue
+		}
+		paths = append(paths, fieldMaskPaths(opts, field.Message(), path+".", append(parents, field.Message().FullName()))...)
+	}
+	return paths
+}
@@ -29,6 +29,26 @@ type Config struct {
 	WithStreamingFlag              *bool
 	FullyQualifiedMessageNamesFlag *bool
 	WithServiceDescriptions        *bool
+	Int64EncodingFlag              *string
+	WithSpecialFloatValuesFlag     *bool
+	QueryParamsMaxDepthFlag        *int
+	DefaultPageSizeFlag            *int
+	MaxPageSizeFlag                *int
+	SingularsFlag                  *string
+	EscapeVerbColonsFlag           *bool
+	EnumOneOfFlag                  *bool
+	OmitEnumUnspecifiedFlag        *bool
+	WithoutEnumDefaultFlag         *bool
+	TrimEnumPrefixFlag             *bool
+	ReportUnsecuredFlag            *bool
+	VisibilityFlag                 *string
+	VariantsFlag                   *string
+	FilterFlag                     *string
+	CommentDirectivesFlag          *string
+	WithDetachedCommentsFlag       *bool
+	CommentSummariesFlag           *bool
+	LocaleFlag                     *string
+	LocaleFallbackFlag             *string
 }
 
 func (c Config) ToOptions() (Options, error) {
@@ -44,12 +64,49 @@ func (c Config) ToOptions() (Options, error) {
 	opts.FullyQualifiedMessageNames = lo.FromPtr(c.FullyQualifiedMessageNamesFlag)
 	opts.WithServiceDescriptions = lo.FromPtr(c.WithServiceDescriptions)
 	opts.IgnoreGoogleapiHTTP = lo.FromPtr(c.IgnoreGoogleApiHttpFlag)
+	opts.WithSpecialFloatValues = lo.FromPtr(c.WithSpecialFloatValuesFlag)
+	opts.EscapeVerbColons = lo.FromPtr(c.EscapeVerbColonsFlag)
+	opts.EnumOneOf = lo.FromPtr(c.EnumOneOfFlag)
+	opts.OmitEnumUnspecified = lo.FromPtr(c.OmitEnumUnspecifiedFlag)
+	opts.WithoutEnumDefault = lo.FromPtr(c.WithoutEnumDefaultFlag)
+	opts.TrimEnumPrefix = lo.FromPtr(c.TrimEnumPrefixFlag)
+	opts.ReportUnsecured = lo.FromPtr(c.ReportUnsecuredFlag)
+	opts.Visibility = ParseVisibility(lo.FromPtr(c.VisibilityFlag))
+	opts.CommentDirectives = ParseCommentDirectives(lo.FromPtr(c.CommentDirectivesFlag))
+	opts.WithDetachedComments = lo.FromPtr(c.WithDetachedCommentsFlag)
+	opts.CommentSummaries = lo.FromPtr(c.CommentSummariesFlag)
+	opts.Locale = lo.FromPtr(c.LocaleFlag)
+	opts.LocaleFallback = lo.FromPtr(c.LocaleFallbackFlag)
 	opts.Path = lo.FromPtr(c.PathFlag)
 	opts.PathPrefix = lo.FromPtr(c.PathPrefixFlag)
 	opts.Format = lo.FromPtr(c.FormatFlag)
 	if !lo.Contains([]string{"yaml", "json"}, opts.Format) {
 		return opts, fmt.Errorf("format be yaml or json, not '%s'", opts.Format)
 	}
+	if c.Int64EncodingFlag != nil {
+		opts.Int64Encoding = *c.Int64EncodingFlag
+	}
+	if !IsValidInt64Encoding(opts.Int64Encoding) {
+		return opts, fmt.Errorf("int64-encoding must be both, string or integer, not '%s'", opts.Int64Encoding)
+	}
+	opts.QueryParamsMaxDepth = lo.FromPtr(c.QueryParamsMaxDepthFlag)
+	if opts.QueryParamsMaxDepth < 0 {
+		return opts, fmt.Errorf("query-params-max-depth must be a non-negative integer, not '%d'", opts.QueryParamsMaxDepth)
+	}
+	opts.DefaultPageSize = lo.FromPtr(c.DefaultPageSizeFlag)
+	if opts.DefaultPageSize < 0 {
+		return opts, fmt.Errorf("default-page-size must be a non-negative integer, not '%d'", opts.DefaultPageSize)
+	}
+	opts.MaxPageSize = lo.FromPtr(c.MaxPageSizeFlag)
+	if opts.MaxPageSize < 0 {
+		return opts, fmt.Errorf("max-page-size must be a non-negative integer, not '%d'", opts.MaxPageSize)
+	}
+
+	singulars, err := ParseSingulars(lo.FromPtr(c.SingularsFlag))
+	if err != nil {
+		return opts, err
+	}
+	opts.Singulars = singulars
 
 	supportedProtocolMap := lo.SliceToMap(Protocols, func(proto Protocol) (string, Protocol) { return proto.Name, proto })
 	opts.ContentTypes = lo.SliceToMap(strings.Split(lo.FromPtr(c.ContentTypesFlag), ";"), func(contentType string) (string, struct{}) {
@@ -81,5 +138,19 @@ func (c Config) ToOptions() (Options, error) {
 	})
 	opts.Services = lo.Filter(lo.Uniq(opts.Services), func(item protoreflect.FullName, index int) bool { return string(item) != "" })
 
+	filters, err := ParseFilters(lo.FromPtr(c.FilterFlag))
+	if err != nil {
+		return opts, err
+	}
+	opts.Filters = filters
+
+	if variantsPath := lo.FromPtr(c.VariantsFlag); variantsPath != "" {
+		variants, err := ReadVariants(variantsPath)
+		if err != nil {
+			return opts, err
+		}
+		opts.Variants = variants
+	}
+
 	return opts, nil
 }
@@ -0,0 +1,138 @@
+package options
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Filter includes or excludes the methods whose full name, or the full name of their service, matches a pattern,
+// or the HTTP paths matching a pattern.
+type Filter struct {
+	// Exclude leaves out what matches, instead of keeping only what matches one of the include filters.
+	Exclude bool
+	// Path matches HTTP paths rather than names.
+	Path bool
+	// Pattern is the pattern as written, without its ! and path: prefixes.
+	Pattern string
+
+	re *regexp.Regexp
+}
+
+// ParseFilters parses a semicolon-separated list of filters. A filter is a glob, where * matches any characters
+// in names and any characters but / in paths and ** matches any characters, or a regular expression prefixed with
+// re:. The path: prefix makes a filter match HTTP paths, and ! makes it exclude what it matches, like
+// lava.*.v1.*, !*.Internal* or !path:/admin/**.
+func ParseFilters(s string) ([]Filter, error) {
+	var filters []Filter
+	for _, pattern := range strings.Split(s, ";") {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			continue
+		}
+		filter, err := ParseFilter(pattern)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter)
+	}
+	return filters, nil
+}
+
+// ParseFilter parses a single filter, see ParseFilters.
+func ParseFilter(s string) (Filter, error) {
+	filter := Filter{}
+	if rest, ok := strings.CutPrefix(s, "!"); ok {
+		filter.Exclude, s = true, rest
+	}
+	if rest, ok := strings.CutPrefix(s, "path:"); ok {
+		filter.Path, s = true, rest
+	}
+	filter.Pattern = s
+
+	expr, isRegexp := strings.CutPrefix(s, "re:")
+	if !isRegexp {
+		expr = globToRegexp(s, filter.Path)
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return filter, fmt.Errorf("invalid filter '%s': %w", s, err)
+	}
+	filter.re = re
+	return filter, nil
+}
+
+// globToRegexp converts a glob to an anchored regular expression. * doesn't match / in paths.
+func globToRegexp(glob string, path bool) string {
+	star := ".*"
+	if path {
+		star = "[^/]*"
+	}
+	var b strings.Builder
+	b.WriteString("^")
+	for i := 0; i < len(glob); i++ {
+		switch {
+		case strings.HasPrefix(glob[i:], "**"):
+			b.WriteString(".*")
+			i++
+		case glob[i] == '*':
+			b.WriteString(star)
+		case glob[i] == '?':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(glob[i : i+1]))
+		}
+	}
+	b.WriteString("$")
+	return b.String()
+}
+
+// Match reports if the filter matches s.
+func (f Filter) Match(s string) bool {
+	return f.re != nil && f.re.MatchString(s)
+}
+
+// HasMethod reports if the name filters keep a method: none of the exclude filters matches it and, if there are
+// include filters, one of them does. Filters match the full name of the method or of its service.
+func (opts Options) HasMethod(method protoreflect.MethodDescriptor) bool {
+	names := []string{string(method.FullName()), string(method.Parent().FullName())}
+	return matchFilters(opts.Filters, false, names...)
+}
+
+// HasPath reports if the path filters keep an HTTP path, like HasMethod for names.
+func (opts Options) HasPath(path string) bool {
+	return matchFilters(opts.Filters, true, path)
+}
+
+// HasPathFilters reports if there are path filters.
+func (opts Options) HasPathFilters() bool {
+	for _, filter := range opts.Filters {
+		if filter.Path {
+			return true
+		}
+	}
+	return false
+}
+
+func matchFilters(filters []Filter, path bool, values ...string) bool {
+	included, hasIncludes := false, false
+	for _, filter := range filters {
+		if filter.Path != path {
+			continue
+		}
+		matched := false
+		for _, value := range values {
+			matched = matched || filter.Match(value)
+		}
+		if filter.Exclude && matched {
+			return false
+		}
+		if !filter.Exclude {
+			hasIncludes = true
+			included = included || matched
+		}
+	}
+	return included || !hasIncludes
+}
@@ -0,0 +1,64 @@
+package options
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterMatch(t *testing.T) {
+	for _, tt := range []struct {
+		filter string
+		value  string
+		match  bool
+	}{
+		{"lava.*.v1.*", "lava.shop.v1.Shop", true},
+		{"lava.*.v1.*", "lava.shop.v1.Shop.GetItem", true},
+		{"lava.*.v1.*", "lava.shop.v2.Shop", false},
+		{"*.Internal*", "lava.shop.v1.InternalShop", true},
+		{"*.Internal*", "lava.shop.v1.Shop", false},
+		{"lava.shop.v1.Shop", "lava.shop.v1.ShopAdmin", false},
+		{"re:^lava\\.(shop|cart)\\.", "lava.cart.v1.Cart", true},
+		{"re:^lava\\.(shop|cart)\\.", "lava.user.v1.User", false},
+		{"path:/admin/**", "/admin/users/1", true},
+		{"path:/admin/*", "/admin/users/1", false},
+		{"path:/admin/*", "/admin/users", true},
+		{"path:/v1/{name}:*", "/v1/{name}:cancel", true},
+		{"path:re:^/v[0-9]+/admin", "/v2/admin/users", true},
+	} {
+		filter, err := ParseFilter(tt.filter)
+		require.NoError(t, err)
+		assert.Equal(t, tt.match, filter.Match(tt.value), "%s on %s", tt.filter, tt.value)
+	}
+}
+
+func TestParseFilters(t *testing.T) {
+	filters, err := ParseFilters("lava.*; !*.Internal* ;!path:/admin/**;")
+	require.NoError(t, err)
+	require.Len(t, filters, 3)
+	for i, want := range []Filter{
+		{Pattern: "lava.*"},
+		{Exclude: true, Pattern: "*.Internal*"},
+		{Exclude: true, Path: true, Pattern: "/admin/**"},
+	} {
+		assert.Equal(t, want.Exclude, filters[i].Exclude, want.Pattern)
+		assert.Equal(t, want.Path, filters[i].Path, want.Pattern)
+		assert.Equal(t, want.Pattern, filters[i].Pattern)
+	}
+
+	_, err = ParseFilters("re:(")
+	assert.Error(t, err)
+}
+
+func TestHasPath(t *testing.T) {
+	filters, err := ParseFilters("path:/v1/**;!path:/v1/admin/**;lava.*")
+	require.NoError(t, err)
+	opts := Options{Filters: filters}
+	assert.True(t, opts.HasPath("/v1/items"))
+	assert.False(t, opts.HasPath("/v1/admin/items"))
+	assert.False(t, opts.HasPath("/v2/items"))
+	assert.True(t, opts.HasPathFilters())
+	assert.True(t, Options{}.HasPath("/anything"))
+	assert.False(t, Options{}.HasPathFilters())
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"regexp"
+	"strconv"
 	"strings"
 
 	"google.golang.org/protobuf/reflect/protoreflect"
@@ -45,33 +47,100 @@ type Options struct {
 	WithServiceDescriptions bool
 	// IgnoreGoogleapiHTTP set to true will cause service to always generate OpenAPI specs for connect endpoints, and ignore any google.api.http options.
 	IgnoreGoogleapiHTTP bool
-	// Services filters which services will be used for generating OpenAPI spec.
+	// Services filters which services will be used for generating OpenAPI spec. They may be globs like lava.*.v1.*.
 	Services []protoreflect.FullName
+	// Filters include or exclude methods by their full name or the full name of their service, and HTTP paths.
+	// Services without any method left are left out too.
+	Filters []Filter
 	// ShortServiceTags uses the short service name (Name()) instead of the full name (FullName()) for OpenAPI tags.
 	ShortServiceTags bool
 	// ShortOperationIds sets the operationId to shortServiceName + "_" + method short name instead of the full method name.
 	ShortOperationIds bool
+	// Int64Encoding is how 64-bit integers are represented: "both" (integer or string, the default), "string"
+	// (what protojson emits) or "integer".
+	Int64Encoding string
+	// WithSpecialFloatValues allows the "NaN", "Infinity" and "-Infinity" strings that protojson uses for
+	// non-finite float and double values.
+	WithSpecialFloatValues bool
+	// QueryParamsMaxDepth limits how many levels of nested messages are flattened into dotted query parameter
+	// names. Deeper message fields become a single JSON-encoded parameter. Zero means no limit.
+	QueryParamsMaxDepth int
+	// DefaultPageSize is the page size documented for AIP-158 list methods when page_size is zero. Zero leaves
+	// it out.
+	DefaultPageSize int
+	// MaxPageSize is the largest page_size documented for AIP-158 list methods. Zero leaves it out.
+	MaxPageSize int
+	// Singulars maps collection names to the singular used for their path parameters, like octopi to octopus.
+	// They take precedence over the singular names of google.api.resource and the built-in English rules.
+	Singulars map[string]string
+	// EscapeVerbColons percent-encodes the colon of custom verbs in paths, like /v1/{name}%3Acancel, for tools
+	// that read a colon as the start of a path parameter.
+	EscapeVerbColons bool
+	// EnumOneOf documents the values of enums as a oneOf of const schemas with a title, description and
+	// deprecation each, instead of the x-enum-varnames, x-enum-descriptions and x-enum-deprecated extensions.
+	EnumOneOf bool
+	// OmitEnumUnspecified leaves the zero value, like STATE_UNSPECIFIED, out of the allowed values of enums.
+	OmitEnumUnspecified bool
+	// WithoutEnumDefault leaves out the default of enums, which is otherwise their zero value.
+	WithoutEnumDefault bool
+	// TrimEnumPrefix strips the ENUM_NAME_ prefix of enum values in their labels, x-enum-varnames or the titles
+	// of their oneOf schemas. The values themselves keep it.
+	TrimEnumPrefix bool
+	// ReportUnsecured logs a warning for every operation without a security requirement of its own or of the
+	// document. Methods declared public aren't reported.
+	ReportUnsecured bool
+	// Visibility lists the visibility labels, like PUBLIC or INTERNAL, documents are generated for. Services,
+	// methods, fields and messages restricted to other labels, with the openapi.v3 options, google.api visibility
+	// rules or an @internal comment, are left out along with the types only they use. Empty keeps everything.
+	Visibility []string
+	// Exclude lists the full names of services, methods, fields and messages left out of the documents.
+	Exclude []protoreflect.FullName
+	// Variants are the documents generated for different audiences from the same descriptors, each with its own
+	// filters, info, servers and output path, instead of a single one.
+	Variants []Variant
+	// Variant is the variant a document is generated for, set in the options returned by VariantOptions.
+	Variant *Variant
+	// CommentDirectives lists prefixes of comment lines, besides util.DefaultCommentDirectives like buf:lint: and
+	// TODO, left out of descriptions.
+	CommentDirectives []string
+	// WithDetachedComments adds the comments detached from a declaration by a blank line to its description.
+	WithDetachedComments bool
+	// CommentSummaries uses the first sentence of the comments of a method as the summary of its operation and the
+	// rest as its description.
+	CommentSummaries bool
+	// Locale is the language, like en or zh, descriptions are documented in when comments have @lang sections.
+	Locale string
+	// LocaleFallback is the language of the descriptions whose comments have no section in Locale.
+	LocaleFallback string
 
 	MessageAnnotator        MessageAnnotator
 	FieldAnnotator          FieldAnnotator
 	FieldReferenceAnnotator FieldReferenceAnnotator
 }
 
+// HasService reports if a service is one of opts.Services, which may be globs like lava.*.v1.*.
 func (opts Options) HasService(serviceName protoreflect.FullName) bool {
 	if len(opts.Services) == 0 {
 		return true
 	}
 	for _, service := range opts.Services {
-		if service == serviceName {
+		if service == serviceName || regexp.MustCompile(globToRegexp(string(service), false)).MatchString(string(serviceName)) {
 			return true
 		}
 	}
 	return false
 }
 
+// FiltersVisibility reports if services, methods, fields or messages are left out by visibility labels or
+// exclusions.
+func (opts Options) FiltersVisibility() bool {
+	return len(opts.Visibility) > 0 || len(opts.Exclude) > 0
+}
+
 func NewOptions() Options {
 	return Options{
-		Format: "yaml",
+		Format:        "yaml",
+		Int64Encoding: "both",
 		ContentTypes: map[string]struct{}{
 			"json": {},
 		},
@@ -116,6 +185,74 @@ func FromString(s string) (Options, error) {
 			opts.ShortServiceTags = true
 		case param == "short-operation-ids":
 			opts.ShortOperationIds = true
+		case param == "with-special-float-values":
+			opts.WithSpecialFloatValues = true
+		case param == "escape-verb-colons":
+			opts.EscapeVerbColons = true
+		case param == "enum-one-of":
+			opts.EnumOneOf = true
+		case param == "omit-enum-unspecified":
+			opts.OmitEnumUnspecified = true
+		case param == "without-enum-default":
+			opts.WithoutEnumDefault = true
+		case param == "trim-enum-prefix":
+			opts.TrimEnumPrefix = true
+		case param == "report-unsecured":
+			opts.ReportUnsecured = true
+		case strings.HasPrefix(param, "visibility="):
+			opts.Visibility = ParseVisibility(param[11:])
+		case strings.HasPrefix(param, "filter="):
+			filters, err := ParseFilters(param[7:])
+			if err != nil {
+				return opts, err
+			}
+			opts.Filters = filters
+		case strings.HasPrefix(param, "comment-directives="):
+			opts.CommentDirectives = ParseCommentDirectives(param[19:])
+		case param == "with-detached-comments":
+			opts.WithDetachedComments = true
+		case param == "comment-summaries":
+			opts.CommentSummaries = true
+		case strings.HasPrefix(param, "locale="):
+			opts.Locale = param[7:]
+		case strings.HasPrefix(param, "locale-fallback="):
+			opts.LocaleFallback = param[16:]
+		case strings.HasPrefix(param, "variants="):
+			variants, err := ReadVariants(param[9:])
+			if err != nil {
+				return opts, err
+			}
+			opts.Variants = variants
+		case strings.HasPrefix(param, "int64-encoding="):
+			encoding := param[15:]
+			if !IsValidInt64Encoding(encoding) {
+				return opts, fmt.Errorf("int64-encoding must be both, string or integer, not '%s'", encoding)
+			}
+			opts.Int64Encoding = encoding
+		case strings.HasPrefix(param, "query-params-max-depth="):
+			depth, err := strconv.Atoi(param[23:])
+			if err != nil || depth < 0 {
+				return opts, fmt.Errorf("query-params-max-depth must be a non-negative integer, not '%s'", param[23:])
+			}
+			opts.QueryParamsMaxDepth = depth
+		case strings.HasPrefix(param, "default-page-size="):
+			size, err := strconv.Atoi(param[18:])
+			if err != nil || size < 0 {
+				return opts, fmt.Errorf("default-page-size must be a non-negative integer, not '%s'", param[18:])
+			}
+			opts.DefaultPageSize = size
+		case strings.HasPrefix(param, "max-page-size="):
+			size, err := strconv.Atoi(param[14:])
+			if err != nil || size < 0 {
+				return opts, fmt.Errorf("max-page-size must be a non-negative integer, not '%s'", param[14:])
+			}
+			opts.MaxPageSize = size
+		case strings.HasPrefix(param, "singulars="):
+			singulars, err := ParseSingulars(param[10:])
+			if err != nil {
+				return opts, err
+			}
+			opts.Singulars = singulars
 		case strings.HasPrefix(param, "content-types="):
 			for _, contentType := range strings.Split(param[14:], ";") {
 				contentType = strings.TrimSpace(contentType)
@@ -188,3 +325,51 @@ func IsValidContentType(contentType string) bool {
 	}
 	return false
 }
+
+// ParseSingulars parses a semicolon-separated list of plural:singular pairs.
+func ParseSingulars(s string) (map[string]string, error) {
+	singulars := map[string]string{}
+	for _, pair := range strings.Split(s, ";") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		plural, singular, ok := strings.Cut(pair, ":")
+		plural, singular = strings.TrimSpace(plural), strings.TrimSpace(singular)
+		if !ok || plural == "" || singular == "" {
+			return nil, fmt.Errorf("singulars must be plural:singular pairs separated by ';', not '%s'", pair)
+		}
+		singulars[plural] = singular
+	}
+	return singulars, nil
+}
+
+// ParseVisibility parses a semicolon-separated list of visibility labels.
+func ParseVisibility(s string) []string {
+	var labels []string
+	for _, label := range strings.Split(s, ";") {
+		if label = strings.TrimSpace(label); label != "" {
+			labels = append(labels, label)
+		}
+	}
+	return labels
+}
+
+// ParseCommentDirectives parses a semicolon-separated list of comment line prefixes.
+func ParseCommentDirectives(s string) []string {
+	var directives []string
+	for _, directive := range strings.Split(s, ";") {
+		if directive = strings.TrimSpace(directive); directive != "" {
+			directives = append(directives, directive)
+		}
+	}
+	return directives
+}
+
+func IsValidInt64Encoding(encoding string) bool {
+	switch encoding {
+	case "both", "string", "integer":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,96 @@
+package options
+
+import (
+	"fmt"
+	"os"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"gopkg.in/yaml.v3"
+)
+
+// Variant is a document generated for one audience, like public, partner or internal, next to the others from
+// the same descriptors.
+type Variant struct {
+	// Name of the variant, which names its document when it has no path.
+	Name string `yaml:"name"`
+	// Path of the document, {name}.openapi.{format} by default.
+	Path string `yaml:"path"`
+	// Services documented in the variant, all of them when empty.
+	Services []protoreflect.FullName `yaml:"services"`
+	// Visibility labels the variant is generated for, like the visibility option.
+	Visibility []string `yaml:"visibility"`
+	// Exclude lists the full names of services, methods, fields and messages left out of the variant.
+	Exclude []protoreflect.FullName `yaml:"exclude"`
+	// Locale is the language of the descriptions of the variant, like the locale option.
+	Locale string `yaml:"locale"`
+	// Info replaces the title, description and version of the document when they are set.
+	Info VariantInfo `yaml:"info"`
+	// Servers of the document.
+	Servers []VariantServer `yaml:"servers"`
+}
+
+type VariantInfo struct {
+	Title       string `yaml:"title"`
+	Description string `yaml:"description"`
+	Version     string `yaml:"version"`
+}
+
+type VariantServer struct {
+	URL         string `yaml:"url"`
+	Description string `yaml:"description"`
+}
+
+// ReadVariants reads the variants listed under the variants key of a YAML file.
+func ReadVariants(path string) ([]Variant, error) {
+	body, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var file struct {
+		Variants []Variant `yaml:"variants"`
+	}
+	if err := yaml.Unmarshal(body, &file); err != nil {
+		return nil, fmt.Errorf("unmarshalling variants: %w", err)
+	}
+	names := map[string]struct{}{}
+	for _, variant := range file.Variants {
+		if variant.Name == "" {
+			return nil, fmt.Errorf("every variant of '%s' needs a name", path)
+		}
+		if _, ok := names[variant.Name]; ok {
+			return nil, fmt.Errorf("variant '%s' is listed twice in '%s'", variant.Name, path)
+		}
+		names[variant.Name] = struct{}{}
+	}
+	return file.Variants, nil
+}
+
+// VariantOptions returns the options of every document to generate: opts itself without variants, or else
+// opts with the filters, locale and output path of each variant.
+func (opts Options) VariantOptions() []Options {
+	if len(opts.Variants) == 0 {
+		return []Options{opts}
+	}
+	result := make([]Options, len(opts.Variants))
+	for i := range opts.Variants {
+		variant := &opts.Variants[i]
+		vopts := opts
+		vopts.Variant = variant
+		vopts.Path = variant.Path
+		if vopts.Path == "" {
+			vopts.Path = variant.Name + ".openapi." + opts.Format
+		}
+		if len(variant.Services) > 0 {
+			vopts.Services = variant.Services
+		}
+		if len(variant.Visibility) > 0 {
+			vopts.Visibility = variant.Visibility
+		}
+		if variant.Locale != "" {
+			vopts.Locale = variant.Locale
+		}
+		vopts.Exclude = append(append([]protoreflect.FullName{}, opts.Exclude...), variant.Exclude...)
+		result[i] = vopts
+	}
+	return result
+}
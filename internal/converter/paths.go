@@ -16,17 +16,23 @@ func addPathItemsFromFile(opts options.Options, fd protoreflect.FileDescriptor,
 	services := fd.Services()
 	for i := 0; i < services.Len(); i++ {
 		service := services.Get(i)
-		if !opts.HasService(service.FullName()) {
+		if !hasService(opts, service) {
 			continue
 		}
 		methods := service.Methods()
 		for j := 0; j < methods.Len(); j++ {
 			method := methods.Get(j)
-			pathItems := googleapi.MakePathItems(opts, method)
+			if !hasMethod(opts, method) {
+				continue
+			}
+			pathItems, _ := googleapi.MakePathItems(opts, method)
 
 			// Helper function to update or set path items
 			addPathItem := func(path string, newItem *v3.PathItem) {
 				path = util.MakePath(opts, path)
+				if !opts.HasPath(path) {
+					return
+				}
 				if existing, ok := paths.PathItems.Get(path); !ok {
 					paths.PathItems.Set(path, newItem)
 				} else {
@@ -220,9 +226,7 @@ func mergeResponse(existing, new *v3.Response) {
 }
 
 func methodToOperaton(opts options.Options, method protoreflect.MethodDescriptor, returnGet bool) *v3.Operation {
-	fd := method.ParentFile()
 	service := method.Parent().(protoreflect.ServiceDescriptor)
-	loc := fd.SourceLocations().ByDescriptor(method)
 	tagName := string(service.FullName())
 	if opts.ShortServiceTags {
 		tagName = string(service.Name())
@@ -233,12 +237,13 @@ func methodToOperaton(opts options.Options, method protoreflect.MethodDescriptor
 		operationId = string(service.Name()) + "_" + string(method.Name())
 	}
 
+	summary, description := util.MethodSummary(opts, method)
 	op := &v3.Operation{
-		Summary:     string(method.Name()),
+		Summary:     summary,
 		OperationId: operationId,
 		Deprecated:  util.IsMethodDeprecated(method),
 		Tags:        []string{tagName},
-		Description: util.FormatComments(loc),
+		Description: description,
 	}
 
 	isStreaming := method.IsStreamingClient() || method.IsStreamingServer()
@@ -248,12 +253,12 @@ func methodToOperaton(opts options.Options, method protoreflect.MethodDescriptor
 
 	// Responses
 	codeMap := orderedmap.New[string, *v3.Response]()
-	outputId := util.FormatTypeRef(string(method.Output().FullName()))
 	codeMap.Set("200", &v3.Response{
 		Description: "Success",
+		Headers:     googleapi.ResponseHeaders(opts, method.Output()),
 		Content: util.MakeMediaTypes(
 			opts,
-			base.CreateSchemaProxyRef("#/components/schemas/"+outputId),
+			googleapi.ResponseBodySchema(opts, method.Output()),
 			false,
 			isStreaming,
 		),
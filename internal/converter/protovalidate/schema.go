@@ -1,7 +1,9 @@
 package protovalidate
 
 import (
+	"fmt"
 	"log/slog"
+	"math/big"
 	"strconv"
 	"strings"
 
@@ -826,13 +828,239 @@ func updateSchemaMap(schema *base.Schema, constraint *validate.MapRules) {
 		v := int64(*constraint.MaxPairs)
 		schema.MaxProperties = &v
 	}
-	// NOTE: Most of these properties don't make sense for object keys
-	// updateSchemaWithFieldRules(schema, constraint.Keys)
+	updateSchemaMapKeys(schema, constraint.Keys)
 	if schema.AdditionalProperties != nil && constraint.Values != nil {
 		updateSchemaWithFieldRules(schema.AdditionalProperties.A.Schema(), constraint.Values, false)
 	}
 }
 
+// updateSchemaMapKeys applies key rules to the propertyNames schema. Keys are always JSON strings, so numeric
+// and bool rules are translated into string constraints on the key's decimal form.
+func updateSchemaMapKeys(schema *base.Schema, constraint *validate.FieldRules) {
+	if constraint == nil || constraint.Type == nil {
+		return
+	}
+	if schema.PropertyNames == nil {
+		schema.PropertyNames = base.CreateSchemaProxy(&base.Schema{Type: []string{"string"}})
+	}
+	names := schema.PropertyNames.Schema()
+	switch t := constraint.Type.(type) {
+	case *validate.FieldRules_String_:
+		updateSchemaString(names, t.String_)
+	case *validate.FieldRules_Bool:
+		if t.Bool.Const != nil {
+			names.Const = utils.CreateStringNode(strconv.FormatBool(*t.Bool.Const))
+		}
+	default:
+		updateSchemaNumericMapKeys(names, constraint)
+	}
+}
+
+// updateSchemaNumericMapKeys translates integer key rules. All integer rule messages share the const, in,
+// not_in, lt, lte, gt and gte fields, so they are read through reflection. Ranges become a pattern matching the
+// keys in the range as protojson writes them, without leading zeros, except for the sign bounds whose simpler
+// patterns also accept them.
+func updateSchemaNumericMapKeys(names *base.Schema, constraint *validate.FieldRules) {
+	msg := constraint.ProtoReflect()
+	rules := msg.Get(msg.WhichOneof(msg.Descriptor().Oneofs().ByName("type"))).Message()
+	fields := rules.Descriptor().Fields()
+	get := func(name protoreflect.Name) (protoreflect.Value, bool) {
+		fd := fields.ByName(name)
+		if fd == nil || !rules.Has(fd) {
+			return protoreflect.Value{}, false
+		}
+		return rules.Get(fd), true
+	}
+	list := func(name protoreflect.Name) []*yaml.Node {
+		v, ok := get(name)
+		if !ok {
+			return nil
+		}
+		items := make([]*yaml.Node, v.List().Len())
+		for i := range items {
+			items[i] = utils.CreateStringNode(fmt.Sprint(v.List().Get(i).Interface()))
+		}
+		return items
+	}
+
+	if v, ok := get("const"); ok {
+		names.Const = utils.CreateStringNode(fmt.Sprint(v.Interface()))
+	}
+	if items := list("in"); items != nil {
+		names.Enum = items
+	}
+	if items := list("not_in"); items != nil {
+		names.Not = base.CreateSchemaProxy(&base.Schema{Type: names.Type, Enum: items})
+	}
+
+	// Each bound is normalized to an inclusive one, nil when the range is open on that side.
+	var lower, upper *big.Int
+	if v, ok := get("gt"); ok {
+		lower = mapKeyBound(v, 1)
+	} else if v, ok := get("gte"); ok {
+		lower = mapKeyBound(v, 0)
+	}
+	if v, ok := get("lt"); ok {
+		upper = mapKeyBound(v, -1)
+	} else if v, ok := get("lte"); ok {
+		upper = mapKeyBound(v, 0)
+	}
+	if lower == nil && upper == nil {
+		return
+	}
+	switch rules.Descriptor().Name() {
+	case "UInt32Rules", "UInt64Rules", "Fixed32Rules", "Fixed64Rules":
+		if lower == nil || lower.Sign() < 0 {
+			lower = big.NewInt(0)
+		}
+	}
+	if lower != nil && upper != nil && lower.Cmp(upper) > 0 {
+		slog.Warn("map key range is empty", slog.Any("rules", rules.Interface()))
+		return
+	}
+
+	switch {
+	case upper == nil && lower.Cmp(big.NewInt(0)) == 0:
+		names.Pattern = "^[0-9]+$"
+	case upper == nil && lower.Cmp(big.NewInt(1)) == 0:
+		names.Pattern = "^0*[1-9][0-9]*$"
+	case lower == nil && upper.Cmp(big.NewInt(0)) == 0:
+		names.Pattern = "^(0+|-[0-9]+)$"
+	case lower == nil && upper.Cmp(big.NewInt(-1)) == 0:
+		names.Pattern = "^-0*[1-9][0-9]*$"
+	default:
+		names.Pattern = "^(" + strings.Join(intRangePatterns(lower, upper), "|") + ")$"
+	}
+}
+
+// mapKeyBound returns a key rule value shifted by delta, which turns an exclusive bound into an inclusive one.
+func mapKeyBound(v protoreflect.Value, delta int64) *big.Int {
+	var bound *big.Int
+	switch x := v.Interface().(type) {
+	case int32:
+		bound = big.NewInt(int64(x))
+	case int64:
+		bound = big.NewInt(x)
+	case uint32:
+		bound = new(big.Int).SetUint64(uint64(x))
+	case uint64:
+		bound = new(big.Int).SetUint64(x)
+	default:
+		return nil
+	}
+	return bound.Add(bound, big.NewInt(delta))
+}
+
+// intRangePatterns returns the alternatives of a regular expression matching the decimal integers from lower
+// to upper, either of them nil for an open range.
+func intRangePatterns(lower, upper *big.Int) []string {
+	var patterns []string
+	if lower == nil || lower.Sign() < 0 {
+		// -upper..-lower are the magnitudes of the negative keys.
+		from := "1"
+		if upper != nil && upper.Sign() < 0 {
+			from = new(big.Int).Neg(upper).String()
+		}
+		var to string
+		if lower != nil {
+			to = new(big.Int).Neg(lower).String()
+		}
+		for _, pattern := range naturalRangePatterns(from, to) {
+			patterns = append(patterns, "-"+pattern)
+		}
+	}
+	if upper == nil || upper.Sign() >= 0 {
+		from := "0"
+		if lower != nil && lower.Sign() > 0 {
+			from = lower.String()
+		}
+		var to string
+		if upper != nil {
+			to = upper.String()
+		}
+		patterns = append(naturalRangePatterns(from, to), patterns...)
+	}
+	return patterns
+}
+
+// naturalRangePatterns returns the alternatives matching the decimal numbers from to to, without leading zeros.
+// An empty to leaves the range open.
+func naturalRangePatterns(from, to string) []string {
+	var patterns []string
+	last := len(to)
+	if to == "" {
+		last = len(from)
+	}
+	for n := len(from); n <= last; n++ {
+		lo, hi := "1"+strings.Repeat("0", n-1), strings.Repeat("9", n)
+		if n == 1 {
+			lo = "0"
+		}
+		if n == len(from) {
+			lo = from
+		}
+		if n == len(to) {
+			hi = to
+		}
+		patterns = append(patterns, digitRangePatterns(lo, hi)...)
+	}
+	if to == "" {
+		patterns = append(patterns, "[1-9][0-9]{"+strconv.Itoa(len(from))+",}")
+	}
+	return patterns
+}
+
+// digitRangePatterns returns the alternatives matching the numbers from lo to hi, which have the same number
+// of digits.
+func digitRangePatterns(lo, hi string) []string {
+	if lo == hi {
+		return []string{lo}
+	}
+	if len(lo) == 1 {
+		return []string{digitClass(lo[0], hi[0])}
+	}
+	if lo[0] == hi[0] {
+		patterns := digitRangePatterns(lo[1:], hi[1:])
+		for i, pattern := range patterns {
+			patterns[i] = lo[:1] + pattern
+		}
+		return patterns
+	}
+
+	// lo up to the end of its leading digit, the full leading digits in between, then up to hi.
+	var patterns []string
+	rest := len(lo) - 1
+	first, final := lo[0], hi[0]
+	if strings.Trim(lo[1:], "0") != "" {
+		for _, pattern := range digitRangePatterns(lo[1:], strings.Repeat("9", rest)) {
+			patterns = append(patterns, lo[:1]+pattern)
+		}
+		first++
+	}
+	var tail []string
+	if strings.Trim(hi[1:], "9") != "" {
+		for _, pattern := range digitRangePatterns(strings.Repeat("0", rest), hi[1:]) {
+			tail = append(tail, hi[:1]+pattern)
+		}
+		final--
+	}
+	if first <= final {
+		digits := "[0-9]"
+		if rest > 1 {
+			digits += "{" + strconv.Itoa(rest) + "}"
+		}
+		patterns = append(patterns, digitClass(first, final)+digits)
+	}
+	return append(patterns, tail...)
+}
+
+func digitClass(lo, hi byte) string {
+	if lo == hi {
+		return string(lo)
+	}
+	return "[" + string(lo) + "-" + string(hi) + "]"
+}
+
 func updateSchemaAny(schema *base.Schema, constraint *validate.AnyRules) {
 	if len(constraint.In) > 0 {
 		items := make([]*yaml.Node, len(constraint.In))
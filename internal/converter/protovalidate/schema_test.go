@@ -0,0 +1,72 @@
+package protovalidate
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func mapKeyPattern(t *testing.T, rules *validate.FieldRules) *regexp.Regexp {
+	t.Helper()
+	names := &base.Schema{Type: []string{"string"}}
+	updateSchemaNumericMapKeys(names, rules)
+	require.NotEmpty(t, names.Pattern)
+	return regexp.MustCompile(names.Pattern)
+}
+
+func TestMapKeyRanges(t *testing.T) {
+	for _, tt := range []struct {
+		name         string
+		rules        *validate.Int64Rules
+		lower, upper int64
+	}{
+		{"years", &validate.Int64Rules{GreaterThan: &validate.Int64Rules_Gte{Gte: 1900}, LessThan: &validate.Int64Rules_Lte{Lte: 2100}}, 1900, 2100},
+		{"exclusive", &validate.Int64Rules{GreaterThan: &validate.Int64Rules_Gt{Gt: 7}, LessThan: &validate.Int64Rules_Lt{Lt: 1000}}, 8, 999},
+		{"across zero", &validate.Int64Rules{GreaterThan: &validate.Int64Rules_Gte{Gte: -35}, LessThan: &validate.Int64Rules_Lte{Lte: 120}}, -35, 120},
+		{"negative", &validate.Int64Rules{GreaterThan: &validate.Int64Rules_Gt{Gt: -1200}, LessThan: &validate.Int64Rules_Lt{Lt: -9}}, -1199, -10},
+		{"from", &validate.Int64Rules{GreaterThan: &validate.Int64Rules_Gte{Gte: 42}}, 42, math.MaxInt64},
+		{"up to", &validate.Int64Rules{LessThan: &validate.Int64Rules_Lte{Lte: 305}}, math.MinInt64, 305},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			re := mapKeyPattern(t, &validate.FieldRules{Type: &validate.FieldRules_Int64{Int64: tt.rules}})
+			for key := int64(-3000); key <= 3000; key++ {
+				assert.Equal(t, key >= tt.lower && key <= tt.upper, re.MatchString(strconv.FormatInt(key, 10)), key)
+			}
+		})
+	}
+}
+
+func TestMapKeyUint64Bounds(t *testing.T) {
+	// The bounds are shifted past math.MaxInt64 without overflowing.
+	re := mapKeyPattern(t, &validate.FieldRules{Type: &validate.FieldRules_Uint64{Uint64: &validate.UInt64Rules{
+		GreaterThan: &validate.UInt64Rules_Gt{Gt: math.MaxUint64 - 2},
+	}}})
+	assert.False(t, re.MatchString(strconv.FormatUint(math.MaxUint64-2, 10)))
+	assert.True(t, re.MatchString(strconv.FormatUint(math.MaxUint64-1, 10)))
+	assert.True(t, re.MatchString(strconv.FormatUint(math.MaxUint64, 10)))
+
+	re = mapKeyPattern(t, &validate.FieldRules{Type: &validate.FieldRules_Uint64{Uint64: &validate.UInt64Rules{
+		LessThan: &validate.UInt64Rules_Lt{Lt: math.MaxInt64 + 1},
+	}}})
+	assert.True(t, re.MatchString("0"))
+	assert.True(t, re.MatchString(strconv.FormatUint(math.MaxInt64, 10)))
+	assert.False(t, re.MatchString(strconv.FormatUint(math.MaxInt64+1, 10)))
+	assert.False(t, re.MatchString("-1"))
+}
+
+func TestMapKeyEmptyRange(t *testing.T) {
+	names := &base.Schema{Type: []string{"string"}}
+	updateSchemaNumericMapKeys(names, &validate.FieldRules{Type: &validate.FieldRules_Int32{Int32: &validate.Int32Rules{
+		GreaterThan: &validate.Int32Rules_Gt{Gt: 5},
+		LessThan:    &validate.Int32Rules_Lt{Lt: 5},
+		Const:       proto.Int32(5),
+	}}})
+	assert.Empty(t, names.Pattern)
+}
@@ -11,6 +11,7 @@ import (
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"gopkg.in/yaml.v3"
 
+	"github.com/pubgo/protoc-gen-openapi/internal/converter/googleapi"
 	"github.com/pubgo/protoc-gen-openapi/internal/converter/options"
 	"github.com/pubgo/protoc-gen-openapi/internal/converter/schema"
 	"github.com/pubgo/protoc-gen-openapi/internal/converter/util"
@@ -34,8 +35,9 @@ func NewState(opts options.Options) *State {
 func (st *State) CollectFile(tt protoreflect.FileDescriptor) {
 	st.CurrentFile = tt
 
-	// Only collect types from the root if TrimUnusedTypes is off
-	if !st.Opts.TrimUnusedTypes {
+	// Only collect types from the root if TrimUnusedTypes is off. Filtering by visibility or exclusions leaves
+	// out the types only used by what it removes, so it trims them too.
+	if !st.Opts.TrimUnusedTypes && !st.Opts.FiltersVisibility() {
 		// Files can have enums
 		enums := tt.Enums()
 		for i := 0; i < enums.Len(); i++ {
@@ -53,11 +55,21 @@ func (st *State) CollectFile(tt protoreflect.FileDescriptor) {
 	services := tt.Services()
 	for i := 0; i < services.Len(); i++ {
 		service := services.Get(i)
+		if !hasService(st.Opts, service) {
+			continue
+		}
 		methods := service.Methods()
 		for j := 0; j < methods.Len(); j++ {
 			method := methods.Get(j)
+			if !hasMethod(st.Opts, method) {
+				continue
+			}
 			st.CollectMessage(method.Input())
 			st.CollectMessage(method.Output())
+			if info := googleapi.GetOperationInfo(method); info != nil {
+				st.CollectMessage(info.Response)
+				st.CollectMessage(info.Metadata)
+			}
 		}
 	}
 }
@@ -74,7 +86,7 @@ func (st *State) CollectEnum(tt protoreflect.EnumDescriptor) {
 }
 
 func (st *State) CollectMessage(tt protoreflect.MessageDescriptor) {
-	if tt == nil {
+	if tt == nil || !util.IsVisible(st.Opts, tt) {
 		return
 	}
 	// Make sure we're not recursing through the same message a second time
@@ -104,7 +116,7 @@ func (st *State) CollectMessage(tt protoreflect.MessageDescriptor) {
 }
 
 func (st *State) CollectField(tt protoreflect.FieldDescriptor) {
-	if tt == nil {
+	if tt == nil || !util.IsVisible(st.Opts, tt) {
 		return
 	}
 	st.CollectEnum(tt.Enum())
@@ -153,7 +165,7 @@ func enumToSchema(state *State, tt protoreflect.EnumDescriptor) (string, *base.S
 	}
 	s := &base.Schema{
 		Title:       title,
-		Description: util.FormatComments(tt.ParentFile().SourceLocations().ByDescriptor(tt)),
+		Description: util.FormatComments(state.Opts, tt.ParentFile().SourceLocations().ByDescriptor(tt)),
 		Type:        []string{"string"},
 		Enum:        children,
 	}
@@ -3,6 +3,7 @@ package schema
 import (
 	"fmt"
 	"log/slog"
+	"math"
 	"slices"
 	"strings"
 
@@ -19,7 +20,7 @@ func MessageToSchema(opts options.Options, tt protoreflect.MessageDescriptor) (s
 	slog.Debug("messageToSchema", slog.Any("descriptor", tt.FullName()))
 	defer slog.Debug("/messageToSchema", slog.Any("descriptor", tt.FullName()))
 	if util.IsWellKnown(tt) {
-		wk := util.WellKnownToSchema(tt)
+		wk := util.WellKnownToSchema(opts, tt)
 		if wk == nil {
 			return "", nil
 		}
@@ -31,7 +32,7 @@ func MessageToSchema(opts options.Options, tt protoreflect.MessageDescriptor) (s
 	}
 	s := &base.Schema{
 		Title:                title,
-		Description:          util.FormatComments(tt.ParentFile().SourceLocations().ByDescriptor(tt)),
+		Description:          util.FormatComments(opts, tt.ParentFile().SourceLocations().ByDescriptor(tt)),
 		Type:                 []string{"object"},
 		AdditionalProperties: &base.DynamicValue[*base.SchemaProxy, bool]{N: 1, B: false},
 	}
@@ -42,6 +43,9 @@ func MessageToSchema(opts options.Options, tt protoreflect.MessageDescriptor) (s
 	fields := tt.Fields()
 	for i := 0; i < fields.Len(); i++ {
 		field := fields.Get(i)
+		if !util.IsVisible(opts, field) {
+			continue
+		}
 		if oneOf := field.ContainingOneof(); oneOf != nil && !oneOf.IsSynthetic() {
 			oneOneGroups[oneOf.FullName()] = append(oneOneGroups[oneOf.FullName()], field)
 			continue
@@ -79,9 +83,29 @@ func MessageToSchema(opts options.Options, tt protoreflect.MessageDescriptor) (s
 
 	// Apply Updates from Options
 	s = opts.MessageAnnotator.AnnotateMessage(opts, s, tt)
+	if s != nil && opts.FiltersVisibility() {
+		s.Required = visibleRequired(opts, tt, s.Required)
+	}
 	return string(tt.FullName()), s
 }
 
+// visibleRequired removes the fields that aren't visible from the required properties of a message, which its
+// openapi.v3.schema option may list.
+func visibleRequired(opts options.Options, tt protoreflect.MessageDescriptor, required []string) []string {
+	fields := tt.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		if field := fields.Get(i); !util.IsVisible(opts, field) {
+			name := util.MakeFieldName(opts, field)
+			required = slices.DeleteFunc(required, func(s string) bool { return s == name })
+		}
+	}
+	// don't serialize []
+	if len(required) == 0 {
+		return nil
+	}
+	return required
+}
+
 func FieldToSchema(opts options.Options, parent *base.SchemaProxy, tt protoreflect.FieldDescriptor) *base.SchemaProxy {
 	slog.Debug("FieldToSchema", slog.Any("descriptor", tt.FullName()))
 	defer slog.Debug("/FieldToSchema", slog.Any("descriptor", tt.FullName()))
@@ -93,6 +117,7 @@ func FieldToSchema(opts options.Options, parent *base.SchemaProxy, tt protorefle
 		root.Type = []string{"object"}
 		root.Description = util.TypeFieldDescription(opts, tt)
 		root.AdditionalProperties = &base.DynamicValue[*base.SchemaProxy, bool]{A: FieldToSchema(opts, parent, tt.MapValue())}
+		root.PropertyNames = MapKeyToSchema(tt.MapKey())
 		root = opts.FieldAnnotator.AnnotateField(opts, root, tt, false)
 		return base.CreateSchemaProxy(root)
 	} else if tt.IsList() {
@@ -112,6 +137,7 @@ func FieldToSchema(opts options.Options, parent *base.SchemaProxy, tt protorefle
 			Type:        []string{"array"},
 			Items:       &base.DynamicValue[*base.SchemaProxy, bool]{A: itemSchema},
 			Deprecated:  util.IsFieldDeprecated(tt),
+			Examples:    util.ParseComments(opts, tt.ParentFile().SourceLocations().ByDescriptor(tt)).ExampleNodes(),
 		}
 		s = opts.FieldAnnotator.AnnotateField(opts, s, tt, false)
 		return base.CreateSchemaProxy(s)
@@ -139,6 +165,7 @@ func ScalarFieldToSchema(opts options.Options, parent *base.SchemaProxy, tt prot
 	if !inContainer {
 		s.Title = string(tt.Name())
 		s.Description = util.TypeFieldDescription(opts, tt)
+		s.Examples = util.ParseComments(opts, tt.ParentFile().SourceLocations().ByDescriptor(tt)).ExampleNodes()
 	}
 
 	switch tt.Kind() {
@@ -150,19 +177,13 @@ func ScalarFieldToSchema(opts options.Options, parent *base.SchemaProxy, tt prot
 	case protoreflect.Fixed32Kind, protoreflect.Uint32Kind: // uint32 types
 		s.Type = []string{"integer"}
 	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind: // int64 types
-		// NOTE: 64-bit integer types can be strings or numbers because they sometimes
-		//       cannot fit into a JSON number type
-		s.Type = []string{"integer", "string"}
-		s.Format = "int64"
+		setInt64Schema(opts, s, false)
 	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind: // uint64 types
-		s.Type = []string{"integer", "string"}
-		s.Format = "int64"
+		setInt64Schema(opts, s, true)
 	case protoreflect.DoubleKind:
-		s.Type = []string{"number"}
-		s.Format = "double"
+		setFloatSchema(opts, s, "double")
 	case protoreflect.FloatKind:
-		s.Type = []string{"number"}
-		s.Format = "float"
+		setFloatSchema(opts, s, "float")
 	case protoreflect.StringKind:
 		s.Type = []string{"string"}
 	case protoreflect.BytesKind:
@@ -174,6 +195,74 @@ func ScalarFieldToSchema(opts options.Options, parent *base.SchemaProxy, tt prot
 	return s
 }
 
+// MapKeyToSchema returns the propertyNames schema for a map key. protojson encodes every map key as a JSON
+// object key, so integer keys become decimal strings and bool keys become "true" or "false". String keys
+// are unconstrained and return nil.
+func MapKeyToSchema(tt protoreflect.FieldDescriptor) *base.SchemaProxy {
+	s := &base.Schema{Type: []string{"string"}}
+	switch tt.Kind() {
+	case protoreflect.BoolKind:
+		s.Enum = []*yaml.Node{utils.CreateStringNode("true"), utils.CreateStringNode("false")}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		s.Pattern = "^-?[0-9]+$"
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		s.Pattern = "^[0-9]+$"
+	default:
+		return nil
+	}
+	return base.CreateSchemaProxy(s)
+}
+
+// setInt64Schema describes a 64-bit integer. protojson always emits these as decimal strings because they
+// cannot safely fit into a JSON number, but accepts both forms when parsing, so the representation is
+// configurable through opts.Int64Encoding.
+func setInt64Schema(opts options.Options, s *base.Schema, unsigned bool) {
+	s.Format = "int64"
+	s.Pattern = "^-?[0-9]+$"
+	if unsigned {
+		s.Format = "uint64"
+		s.Pattern = "^[0-9]+$"
+	}
+
+	switch opts.Int64Encoding {
+	case "string":
+		s.Type = []string{"string"}
+	case "integer":
+		s.Type = []string{"integer"}
+		s.Pattern = ""
+	default:
+		s.Type = []string{"integer", "string"}
+	}
+
+	// Bounds only apply to the integer form, the pattern covers the string form. The limits are not
+	// representable as float64, so the bounds are exclusive and sit one float past the limit; validators
+	// that compare in float64 would otherwise reject the limit itself.
+	if s.Type[0] != "integer" {
+		return
+	}
+	upper := float64(math.MaxInt64)
+	if unsigned {
+		upper = float64(math.MaxUint64)
+		util.SetNonNegative(s)
+	} else {
+		s.ExclusiveMinimum = &base.DynamicValue[bool, float64]{N: 1, B: math.Nextafter(math.MinInt64, math.Inf(-1))}
+	}
+	s.ExclusiveMaximum = &base.DynamicValue[bool, float64]{N: 1, B: math.Nextafter(upper, math.Inf(1))}
+}
+
+// setFloatSchema describes a float or double. With opts.WithSpecialFloatValues the schema also accepts
+// the strings protojson uses for non-finite values.
+func setFloatSchema(opts options.Options, s *base.Schema, format string) {
+	s.Type = []string{"number"}
+	s.Format = format
+	if opts.WithSpecialFloatValues {
+		s.Type = []string{"number", "string"}
+		s.Pattern = "^(NaN|-?Infinity)$"
+	}
+}
+
 func ReferenceFieldToSchema(opts options.Options, parent *base.SchemaProxy, tt protoreflect.FieldDescriptor) *base.SchemaProxy {
 	switch tt.Kind() {
 	case protoreflect.MessageKind:
@@ -0,0 +1,98 @@
+package converter
+
+import (
+	"log/slog"
+	"strings"
+
+	openapiv3 "github.com/google/gnostic-models/openapiv3"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pubgo/protoc-gen-openapi/generator"
+	"github.com/pubgo/protoc-gen-openapi/internal/converter/gnostic"
+	"github.com/pubgo/protoc-gen-openapi/internal/converter/options"
+	"github.com/pubgo/protoc-gen-openapi/internal/converter/util"
+	"github.com/samber/lo"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// inheritSecurity gives an operation that doesn't declare its own security, with the openapi.v3.method or
+// openapi.v3.operation options, the security of its service, or else the one of its file. The scopes of the
+// openapi.v3.method option are added to the inherited requirements of oauth2 and openIdConnect schemes, the
+// only ones with scopes.
+func inheritSecurity(op *v3.Operation, srv *generator.Service, method protoreflect.MethodDescriptor) {
+	if op.Security != nil {
+		return
+	}
+	if len(srv.GetSecurity()) > 0 {
+		securityList := lo.Map(srv.GetSecurity(), func(item *openapiv3.NamedStringArray, index int) *openapiv3.SecurityRequirement {
+			return &openapiv3.SecurityRequirement{AdditionalProperties: []*openapiv3.NamedStringArray{item}}
+		})
+		op.Security = gnostic.ToSecurityRequirements(securityList)
+	} else {
+		op.Security = util.SecurityRequirements(util.GetFileOptions(method.ParentFile()).GetSecurity())
+	}
+
+	scopes := util.GetMethodOptions(method).GetScopes()
+	if len(scopes) == 0 {
+		return
+	}
+	scoped := scopedSchemes(method.ParentFile(), map[string]bool{})
+	for _, req := range op.Security {
+		for pair := req.Requirements.First(); pair != nil; pair = pair.Next() {
+			if scoped[pair.Key()] {
+				req.Requirements.Set(pair.Key(), lo.Uniq(append(pair.Value(), scopes...)))
+			}
+		}
+	}
+}
+
+// scopedSchemes returns the names of the oauth2 and openIdConnect security schemes declared by the
+// openapi.v3.file option of a file or of the files it imports.
+func scopedSchemes(fd protoreflect.FileDescriptor, seen map[string]bool) map[string]bool {
+	schemes := map[string]bool{}
+	if seen[fd.Path()] {
+		return schemes
+	}
+	seen[fd.Path()] = true
+	imports := fd.Imports()
+	for i := 0; i < imports.Len(); i++ {
+		for name := range scopedSchemes(imports.Get(i).FileDescriptor, seen) {
+			schemes[name] = true
+		}
+	}
+	for _, scheme := range util.GetFileOptions(fd).GetSecuritySchemes() {
+		switch scheme.GetType().(type) {
+		case *generator.AuthScheme_Oauth2, *generator.AuthScheme_OpenIdConnectUrl:
+			schemes[scheme.GetName()] = true
+		}
+	}
+	return schemes
+}
+
+// unsecuredOperations lists the operations of a document, as "METHOD /path", that have no security requirement
+// of their own and don't inherit one from the document. Operations with an explicitly empty list of security
+// requirements, like the ones of public methods, are left out.
+func unsecuredOperations(doc *v3.Document, additional *util.AdditionalOperations) []string {
+	if len(doc.Security) > 0 || doc.Paths == nil {
+		return nil
+	}
+	var unsecured []string
+	for path, item := range doc.Paths.PathItems.FromOldest() {
+		for method, op := range additional.Operations(path, item).FromOldest() {
+			if op.Security == nil {
+				unsecured = append(unsecured, strings.ToUpper(method)+" "+path)
+			}
+		}
+	}
+	return unsecured
+}
+
+// reportUnsecured logs a warning for every operation listed by unsecuredOperations when opts.ReportUnsecured is
+// set.
+func reportUnsecured(opts options.Options, name string, doc *v3.Document, additional *util.AdditionalOperations) {
+	if !opts.ReportUnsecured {
+		return
+	}
+	for _, operation := range unsecuredOperations(doc, additional) {
+		slog.Warn("operation has no security requirement", slog.String("file", name), slog.String("operation", operation))
+	}
+}
@@ -2,9 +2,14 @@ package converter
 
 import (
 	"github.com/pb33f/libopenapi/datamodel/high/base"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"github.com/pb33f/libopenapi/utils"
+	"github.com/pubgo/protoc-gen-openapi/internal/converter/gnostic"
+	"github.com/pubgo/protoc-gen-openapi/internal/converter/googleapi"
 	"github.com/pubgo/protoc-gen-openapi/internal/converter/options"
 	"github.com/pubgo/protoc-gen-openapi/internal/converter/util"
 	"google.golang.org/protobuf/reflect/protoreflect"
+	"gopkg.in/yaml.v3"
 )
 
 func fileToTags(opts options.Options, fd protoreflect.FileDescriptor) []*base.Tag {
@@ -12,20 +17,33 @@ func fileToTags(opts options.Options, fd protoreflect.FileDescriptor) []*base.Ta
 	services := fd.Services()
 	for i := 0; i < services.Len(); i++ {
 		service := services.Get(i)
-		if !opts.HasService(service.FullName()) {
+		if !hasService(opts, service) {
 			continue
 		}
 		loc := fd.SourceLocations().ByDescriptor(service)
-		description := util.FormatComments(loc)
+		description := util.FormatComments(opts, loc)
 
 		tagName := string(service.FullName())
 		if opts.ShortServiceTags {
 			tagName = string(service.Name())
 		}
-		tags = append(tags, &base.Tag{
+		tag := &base.Tag{
 			Name:        tagName,
 			Description: description,
-		})
+		}
+		if srv := googleapi.GetSrvOptions(opts, service); srv != nil {
+			if srv.TagDescription != "" {
+				tag.Description = srv.TagDescription
+			}
+			tag.Extensions = gnostic.ToExtensions(srv.TagExtensions)
+			if srv.TagDisplayName != "" {
+				if tag.Extensions == nil {
+					tag.Extensions = orderedmap.New[string, *yaml.Node]()
+				}
+				tag.Extensions.Set("x-displayName", utils.CreateStringNode(srv.TagDisplayName))
+			}
+		}
+		tags = append(tags, tag)
 	}
 	return tags
 }
@@ -0,0 +1,294 @@
+package util
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/pb33f/libopenapi/utils"
+	"github.com/pubgo/protoc-gen-openapi/internal/converter/options"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultCommentDirectives are the prefixes of comment lines meant for tools or maintainers rather than the
+// readers of the documentation, which are left out of it.
+var DefaultCommentDirectives = []string{"buf:lint:", "protolint:", "TODO", "FIXME"}
+
+// internalTag matches an @internal tag anywhere in a comment line.
+var internalTag = regexp.MustCompile(`(^|\s+)@internal\b`)
+
+// Comments is the documentation written in the comments of a descriptor.
+type Comments struct {
+	// Text is the markdown of the comments, without directives and tags.
+	Text string
+	// Deprecated is set by a @deprecated tag. The text following the tag is the last paragraph of Text.
+	Deprecated bool
+	// Internal is set by an @internal tag.
+	Internal bool
+	// Examples are the texts of the @example tags, which run until a blank line or the next tag.
+	Examples []string
+}
+
+// ParseComments parses the leading and trailing comments of a descriptor, and its leading detached comments
+// with opts.WithDetachedComments. Paragraphs, lists and code blocks are kept, lines starting with a directive of
+// DefaultCommentDirectives or opts.CommentDirectives are left out and the @deprecated, @example and @internal
+// tags are taken out of the text. Comments written in several languages start each one with a @lang tag, like
+// "@lang zh:", and are documented in opts.Locale, or else in opts.LocaleFallback.
+func ParseComments(opts options.Options, loc protoreflect.SourceLocation) Comments {
+	var blocks []string
+	if opts.WithDetachedComments {
+		blocks = append(blocks, loc.LeadingDetachedComments...)
+	}
+	blocks = append(blocks, loc.LeadingComments, loc.TrailingComments)
+	directives := append(slices.Clone(DefaultCommentDirectives), opts.CommentDirectives...)
+	locales := slices.DeleteFunc([]string{opts.Locale, opts.LocaleFallback}, func(locale string) bool { return locale == "" })
+
+	c := Comments{}
+	var paragraphs []string
+	for _, block := range blocks {
+		if text := c.parseBlock(block, directives, locales); text != "" {
+			paragraphs = append(paragraphs, text)
+		}
+	}
+	c.Text = strings.Join(paragraphs, "\n\n")
+	return c
+}
+
+// commentSection is the text of a comment written in one language, or outside of any @lang section.
+type commentSection struct {
+	lines       []string
+	deprecation string
+}
+
+// empty reports if a section has no text.
+func (s *commentSection) empty() bool {
+	return s.deprecation == "" && !slices.ContainsFunc(s.lines, func(line string) bool { return line != "" })
+}
+
+// text returns the markdown of a section, ending with the reason of its @deprecated tag.
+func (s *commentSection) text() string {
+	lines := s.lines
+	if s.deprecation != "" {
+		for len(lines) > 0 && lines[len(lines)-1] == "" {
+			lines = lines[:len(lines)-1]
+		}
+		lines = append(lines, "", "Deprecated: "+s.deprecation)
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+// parseBlock returns the text of a single comment in the language picked by pickSection, collecting its tags.
+func (c *Comments) parseBlock(block string, directives []string, locales []string) string {
+	sections := map[string]*commentSection{"": {}}
+	langs := []string{""}
+	section := sections[""]
+	fenced, inExample := false, false
+	for _, line := range dedent(strings.Split(block, "\n")) {
+		trimmed := strings.TrimSpace(line)
+		fence := strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~")
+		if !fenced && !fence {
+			line = strings.TrimRight(line, " \t")
+			if trimmed == "" {
+				inExample = false
+			}
+			if tag, rest, ok := commentTag(trimmed); ok {
+				inExample = false
+				switch tag {
+				case "@deprecated":
+					c.Deprecated = true
+					section.deprecation = rest
+				case "@internal":
+					c.Internal = true
+				case "@example":
+					c.Examples = append(c.Examples, rest)
+					inExample = true
+				case "@lang":
+					lang, text := commentLang(rest)
+					if sections[lang] == nil {
+						sections[lang] = &commentSection{}
+						langs = append(langs, lang)
+					}
+					section = sections[lang]
+					line, trimmed = text, text
+				}
+				if line == "" || tag != "@lang" {
+					continue
+				}
+			}
+			if slices.ContainsFunc(directives, func(d string) bool { return d != "" && strings.HasPrefix(trimmed, d) }) {
+				continue
+			}
+			if internalTag.MatchString(line) {
+				c.Internal = true
+				line = internalTag.ReplaceAllString(line, "")
+			}
+		}
+		if fence {
+			fenced = !fenced
+		}
+		if inExample {
+			example := &c.Examples[len(c.Examples)-1]
+			*example = strings.TrimLeft(*example+"\n"+line, "\n")
+			continue
+		}
+		// Blank lines separate paragraphs, one is enough.
+		if line == "" && !fenced && (len(section.lines) == 0 || section.lines[len(section.lines)-1] == "") {
+			continue
+		}
+		section.lines = append(section.lines, line)
+	}
+	return pickSection(sections, langs, locales).text()
+}
+
+// pickSection returns the section of the first of locales a comment has text in. Comments without any are
+// documented with their text outside of @lang sections, or else with their first section.
+func pickSection(sections map[string]*commentSection, langs []string, locales []string) *commentSection {
+	for _, locale := range locales {
+		base, _, _ := strings.Cut(locale, "-")
+		for _, lang := range langs {
+			if lang != "" && (strings.EqualFold(lang, locale) || strings.EqualFold(lang, base)) && !sections[lang].empty() {
+				return sections[lang]
+			}
+		}
+	}
+	for _, lang := range langs {
+		if !sections[lang].empty() {
+			return sections[lang]
+		}
+	}
+	return sections[""]
+}
+
+// commentLang splits the text following a @lang tag, like "zh: 获取一本书。", into the language and the text.
+func commentLang(s string) (string, string) {
+	end := strings.IndexFunc(s, func(r rune) bool { return r == ':' || unicode.IsSpace(r) })
+	if end < 0 {
+		return s, ""
+	}
+	lang, text := s[:end], strings.TrimSpace(s[end:])
+	return lang, strings.TrimSpace(strings.TrimPrefix(text, ":"))
+}
+
+// commentTag splits a line starting with one of the supported tags into the tag and the text following it.
+func commentTag(line string) (string, string, bool) {
+	if !strings.HasPrefix(line, "@") {
+		return "", "", false
+	}
+	tag, rest, _ := strings.Cut(line, " ")
+	switch tag {
+	case "@deprecated", "@internal", "@example", "@lang":
+		return tag, strings.TrimSpace(rest), true
+	}
+	return "", "", false
+}
+
+// dedent removes the indentation shared by the lines of a comment, and the * gutter of /** block comments, so
+// the indentation left is the one of its markdown. Only a comment whose first line starts right after /* with a
+// * has a gutter, line comments like "// * item" keep their bullets.
+func dedent(lines []string) []string {
+	gutter := len(lines) > 0 && strings.HasPrefix(lines[0], "*")
+	if gutter {
+		lines[0] = strings.TrimPrefix(lines[0], "*")
+		for _, line := range lines[1:] {
+			if trimmed := strings.TrimSpace(line); trimmed != "" && !strings.HasPrefix(trimmed, "*") {
+				gutter = false
+				break
+			}
+		}
+	}
+	indent := -1
+	for i, line := range lines {
+		if gutter && i > 0 {
+			line = strings.TrimPrefix(strings.TrimLeft(line, " \t"), "*")
+			lines[i] = line
+		}
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		if n := len(line) - len(strings.TrimLeft(line, " \t")); indent < 0 || n < indent {
+			indent = n
+		}
+	}
+	for i, line := range lines {
+		if len(line) >= indent && indent > 0 {
+			lines[i] = line[indent:]
+		} else {
+			lines[i] = strings.TrimLeft(line, " \t")
+		}
+	}
+	return lines
+}
+
+// ExampleNodes returns the examples of the comments as YAML values, or as strings when they aren't valid YAML.
+func (c Comments) ExampleNodes() []*yaml.Node {
+	var nodes []*yaml.Node
+	for _, example := range c.Examples {
+		var doc yaml.Node
+		if err := yaml.Unmarshal([]byte(example), &doc); err == nil && len(doc.Content) == 1 {
+			nodes = append(nodes, doc.Content[0])
+		} else if example != "" {
+			nodes = append(nodes, utils.CreateStringNode(example))
+		}
+	}
+	return nodes
+}
+
+// MethodSummary returns the summary and description of the operation of a method. The summary is the name of
+// the method, or with opts.CommentSummaries the first sentence of its comments.
+func MethodSummary(opts options.Options, method protoreflect.MethodDescriptor) (string, string) {
+	comments := ParseComments(opts, method.ParentFile().SourceLocations().ByDescriptor(method))
+	if !opts.CommentSummaries {
+		return string(method.Name()), comments.Text
+	}
+	summary, description := splitSummary(comments.Text)
+	if summary == "" {
+		return string(method.Name()), description
+	}
+	return summary, description
+}
+
+// Summary returns the first sentence of the comments.
+func (c Comments) Summary() string {
+	summary, _ := splitSummary(c.Text)
+	return summary
+}
+
+// Description returns the comments following their first sentence.
+func (c Comments) Description() string {
+	_, description := splitSummary(c.Text)
+	return description
+}
+
+// splitSummary splits the first sentence of the first paragraph of a text from the rest of it. A sentence ends
+// with a period, exclamation or question mark followed by the end of the paragraph or by a capital letter, or
+// with an ideographic full stop.
+func splitSummary(text string) (string, string) {
+	paragraph, rest, _ := strings.Cut(text, "\n\n")
+	runes := []rune(paragraph)
+	end := len(runes)
+	for i, r := range runes {
+		if r == '。' || r == '！' || r == '？' {
+			end = i + 1
+			break
+		}
+		if r != '.' && r != '!' && r != '?' {
+			continue
+		}
+		next := strings.TrimLeft(string(runes[i+1:]), " \t\n")
+		if next == "" {
+			break
+		}
+		if next != string(runes[i+1:]) && unicode.IsUpper([]rune(next)[0]) {
+			end = i + 1
+			break
+		}
+	}
+	summary := strings.Join(strings.Fields(string(runes[:end])), " ")
+	description := strings.TrimSpace(string(runes[end:]))
+	if rest != "" {
+		description = strings.TrimSpace(description + "\n\n" + rest)
+	}
+	return summary, description
+}
@@ -0,0 +1,109 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/pubgo/protoc-gen-openapi/internal/converter/options"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func TestParseComments(t *testing.T) {
+	loc := protoreflect.SourceLocation{
+		LeadingDetachedComments: []string{" Copyright the authors.\n"},
+		LeadingComments: ` Get a book. Returns NOT_FOUND when it doesn't exist.
+ buf:lint:ignore RPC_REQUEST_STANDARD_NAME
+
+ Books are looked up by:
+ - name
+ - ISBN
+
+     GET /v1/books/1
+ NOLINT
+ @example {"name": "books/1"}
+ @deprecated use GetBookV2.
+ TODO: cache the books.
+`,
+		TrailingComments: " Cheap. @internal\n",
+	}
+
+	c := ParseComments(options.Options{CommentDirectives: []string{"NOLINT"}}, loc)
+	assert.Equal(t, "Get a book. Returns NOT_FOUND when it doesn't exist.\n\n"+
+		"Books are looked up by:\n- name\n- ISBN\n\n    GET /v1/books/1\n\n"+
+		"Deprecated: use GetBookV2.\n\nCheap.", c.Text)
+	assert.True(t, c.Deprecated)
+	assert.True(t, c.Internal)
+	assert.Equal(t, []string{`{"name": "books/1"}`}, c.Examples)
+	assert.Equal(t, "Get a book.", c.Summary())
+	assert.Equal(t, "Returns NOT_FOUND when it doesn't exist.\n\nBooks are looked up by:\n- name\n- ISBN\n\n"+
+		"    GET /v1/books/1\n\nDeprecated: use GetBookV2.\n\nCheap.", c.Description())
+
+	c = ParseComments(options.Options{WithDetachedComments: true}, loc)
+	assert.Equal(t, "Copyright the authors.", c.Summary())
+}
+
+func TestParseCommentsFences(t *testing.T) {
+	c := ParseComments(options.Options{}, protoreflect.SourceLocation{
+		LeadingComments: "* Runs a query.\n*\n* ```\n* TODO: kept in code\n*\n*\n* ```\n",
+	})
+	assert.Equal(t, "Runs a query.\n\n```\nTODO: kept in code\n\n\n```", c.Text)
+
+	// protoc strips the gutter of the lines following /**, leaving the * of the opening line.
+	c = ParseComments(options.Options{}, protoreflect.SourceLocation{
+		LeadingComments: "*\n Runs a query.\n   indented\n",
+	})
+	assert.Equal(t, "Runs a query.\n  indented", c.Text)
+}
+
+func TestParseCommentsBullets(t *testing.T) {
+	c := ParseComments(options.Options{}, protoreflect.SourceLocation{
+		LeadingComments: " * name\n * ISBN\n",
+	})
+	assert.Equal(t, "* name\n* ISBN", c.Text)
+}
+
+func TestSplitSummary(t *testing.T) {
+	for text, want := range map[string][2]string{
+		"Gets a book.":                   {"Gets a book.", ""},
+		"Gets v1.2 of a book. Or fails.": {"Gets v1.2 of a book.", "Or fails."},
+		"Gets a book\nby name.\n\nMore.": {"Gets a book by name.", "More."},
+		"获取一本书。如果不存在则返回错误。":              {"获取一本书。", "如果不存在则返回错误。"},
+		"Is it there? yes, probably.":    {"Is it there? yes, probably.", ""},
+		"":                               {"", ""},
+	} {
+		summary, description := splitSummary(text)
+		assert.Equal(t, want, [2]string{summary, description}, text)
+	}
+}
+
+func TestParseCommentsLocales(t *testing.T) {
+	loc := protoreflect.SourceLocation{
+		LeadingComments: ` @lang en: Get a book.
+ Returns NOT_FOUND when it doesn't exist.
+ @lang zh:
+ 获取一本书。
+ @deprecated 请使用 GetBookV2。
+`,
+		TrailingComments: " Cheap.\n",
+	}
+	for locale, want := range map[string]string{
+		"":      "Get a book.\nReturns NOT_FOUND when it doesn't exist.\n\nCheap.",
+		"en":    "Get a book.\nReturns NOT_FOUND when it doesn't exist.\n\nCheap.",
+		"zh":    "获取一本书。\n\nDeprecated: 请使用 GetBookV2。\n\nCheap.",
+		"zh-CN": "获取一本书。\n\nDeprecated: 请使用 GetBookV2。\n\nCheap.",
+		"ja":    "Get a book.\nReturns NOT_FOUND when it doesn't exist.\n\nCheap.",
+	} {
+		c := ParseComments(options.Options{Locale: locale, LocaleFallback: "en"}, loc)
+		assert.Equal(t, want, c.Text, locale)
+		assert.True(t, c.Deprecated, locale)
+	}
+
+	c := ParseComments(options.Options{Locale: "ja", LocaleFallback: "zh"}, protoreflect.SourceLocation{
+		LeadingComments: " A book.\n @lang zh: 一本书。\n",
+	})
+	assert.Equal(t, "一本书。", c.Text)
+	c = ParseComments(options.Options{Locale: "ja"}, protoreflect.SourceLocation{
+		LeadingComments: " A book.\n @lang zh: 一本书。\n",
+	})
+	assert.Equal(t, "A book.", c.Text)
+}
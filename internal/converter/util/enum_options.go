@@ -0,0 +1,96 @@
+package util
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/pubgo/protoc-gen-openapi/generator"
+	"github.com/pubgo/protoc-gen-openapi/internal/converter/options"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// GetEnumValueOptions returns the openapi.v3.enum_value option of an enum value, or nil if it isn't set.
+func GetEnumValueOptions(value protoreflect.EnumValueDescriptor) *generator.EnumValue {
+	valueOpts := value.Options()
+	if valueOpts == nil || !proto.HasExtension(valueOpts, generator.E_EnumValue) {
+		return nil
+	}
+
+	valueOption, ok := proto.GetExtension(valueOpts, generator.E_EnumValue).(*generator.EnumValue)
+	if !ok {
+		return nil
+	}
+
+	return valueOption
+}
+
+// IsEnumValueDeprecated reports if an enum value has the deprecated option, a @deprecated tag in its comments or
+// is documented as deprecated by the openapi.v3.enum_value option.
+func IsEnumValueDeprecated(value protoreflect.EnumValueDescriptor) bool {
+	if valueOpts, ok := value.Options().(*descriptorpb.EnumValueOptions); ok && valueOpts.GetDeprecated() {
+		return true
+	}
+	if ParseComments(options.Options{}, value.ParentFile().SourceLocations().ByDescriptor(value)).Deprecated {
+		return true
+	}
+	return GetEnumValueOptions(value).GetDeprecated()
+}
+
+// EnumValues returns the values of an enum that are documented as allowed. The zero value is left out when
+// omitZero is set, as it is with opts.OmitEnumUnspecified or when protovalidate rejects it.
+func EnumValues(enum protoreflect.EnumDescriptor, omitZero bool) []protoreflect.EnumValueDescriptor {
+	values := enum.Values()
+	result := make([]protoreflect.EnumValueDescriptor, 0, values.Len())
+	for i := 0; i < values.Len(); i++ {
+		if omitZero && values.Get(i).Number() == 0 {
+			continue
+		}
+		result = append(result, values.Get(i))
+	}
+	return result
+}
+
+// EnumValueLabel is the display label of an enum value: the label of its openapi.v3.enum_value option, or else
+// its name without the ENUM_NAME_ prefix when opts.TrimEnumPrefix is set.
+func EnumValueLabel(opts options.Options, value protoreflect.EnumValueDescriptor) string {
+	if label := GetEnumValueOptions(value).GetLabel(); label != "" {
+		return label
+	}
+	name := string(value.Name())
+	if !opts.TrimEnumPrefix {
+		return name
+	}
+	enum, ok := value.Parent().(protoreflect.EnumDescriptor)
+	if !ok {
+		return name
+	}
+	trimmed := strings.TrimPrefix(name, screamingSnake(string(enum.Name()))+"_")
+	// Keep names that would be empty or not start with a letter without the prefix.
+	if trimmed == name || trimmed == "" || !unicode.IsLetter(rune(trimmed[0])) {
+		return name
+	}
+	return trimmed
+}
+
+// EnumValueDescription is the description of an enum value, from the openapi.v3.enum_value option or its comments.
+func EnumValueDescription(opts options.Options, value protoreflect.EnumValueDescriptor) string {
+	if description := GetEnumValueOptions(value).GetDescription(); description != "" {
+		return description
+	}
+	return FormatComments(opts, value.ParentFile().SourceLocations().ByDescriptor(value))
+}
+
+// screamingSnake turns a CamelCase enum name into the SCREAMING_SNAKE_CASE prefix of its values.
+func screamingSnake(name string) string {
+	var b strings.Builder
+	runes := []rune(name)
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) && (!unicode.IsUpper(runes[i-1]) || i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+			b.WriteByte('_')
+		}
+		b.WriteRune(unicode.ToUpper(r))
+	}
+	return b.String()
+}
@@ -0,0 +1,17 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScreamingSnake(t *testing.T) {
+	for name, prefix := range map[string]string{
+		"State":      "STATE",
+		"TaskState":  "TASK_STATE",
+		"HTTPMethod": "HTTP_METHOD",
+	} {
+		assert.Equal(t, prefix, screamingSnake(name), name)
+	}
+}
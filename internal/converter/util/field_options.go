@@ -0,0 +1,22 @@
+package util
+
+import (
+	"github.com/pubgo/protoc-gen-openapi/generator"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// GetFieldOptions returns the openapi.v3.field option of a field, or nil if it isn't set.
+func GetFieldOptions(field protoreflect.FieldDescriptor) *generator.Field {
+	fieldOpts := field.Options()
+	if fieldOpts == nil || !proto.HasExtension(fieldOpts, generator.E_Field) {
+		return nil
+	}
+
+	fieldOption, ok := proto.GetExtension(fieldOpts, generator.E_Field).(*generator.Field)
+	if !ok {
+		return nil
+	}
+
+	return fieldOption
+}
@@ -0,0 +1,22 @@
+package util
+
+import (
+	"github.com/pubgo/protoc-gen-openapi/generator"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// GetFileOptions returns the openapi.v3.file option of a file, or nil if it isn't set.
+func GetFileOptions(fd protoreflect.FileDescriptor) *generator.File {
+	fileOpts := fd.Options()
+	if fileOpts == nil || !proto.HasExtension(fileOpts, generator.E_File) {
+		return nil
+	}
+
+	fileOption, ok := proto.GetExtension(fileOpts, generator.E_File).(*generator.File)
+	if !ok {
+		return nil
+	}
+
+	return fileOption
+}
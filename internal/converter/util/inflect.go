@@ -0,0 +1,152 @@
+package util
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// irregularPlurals maps plurals that no suffix rule covers to their singular form.
+var irregularPlurals = map[string]string{
+	"people":      "person",
+	"men":         "man",
+	"women":       "woman",
+	"children":    "child",
+	"mice":        "mouse",
+	"geese":       "goose",
+	"feet":        "foot",
+	"teeth":       "tooth",
+	"oxen":        "ox",
+	"dice":        "die",
+	"criteria":    "criterion",
+	"phenomena":   "phenomenon",
+	"indices":     "index",
+	"vertices":    "vertex",
+	"matrices":    "matrix",
+	"appendices":  "appendix",
+	"axes":        "axis",
+	"analyses":    "analysis",
+	"crises":      "crisis",
+	"diagnoses":   "diagnosis",
+	"hypotheses":  "hypothesis",
+	"parentheses": "parenthesis",
+	"synopses":    "synopsis",
+	"theses":      "thesis",
+	"quizzes":     "quiz",
+	"lives":       "life",
+	"wives":       "wife",
+	"knives":      "knife",
+	"leaves":      "leaf",
+	"loaves":      "loaf",
+	"thieves":     "thief",
+	"halves":      "half",
+	"calves":      "calf",
+	"wolves":      "wolf",
+	"shelves":     "shelf",
+	"selves":      "self",
+	"elves":       "elf",
+	"scarves":     "scarf",
+	"hooves":      "hoof",
+}
+
+// uncountableNouns are the same in singular and plural.
+var uncountableNouns = map[string]struct{}{
+	"data":        {},
+	"metadata":    {},
+	"media":       {},
+	"information": {},
+	"equipment":   {},
+	"series":      {},
+	"species":     {},
+	"news":        {},
+	"sheep":       {},
+	"fish":        {},
+	"deer":        {},
+}
+
+// pluralInitialism matches an all-caps initialism with a lowercase plural s.
+var pluralInitialism = regexp.MustCompile(`^[A-Z]{2,}s$`)
+
+// singularRules are tried in order, the first matching rule wins.
+var singularRules = []struct {
+	pattern     *regexp.Regexp
+	replacement string
+}{
+	// Singular ends in -s or -e, or in -u, and would otherwise be caught by the rules below.
+	{regexp.MustCompile(`(alias|status|^bus|campus|virus|census|canvas|bonus|octopus|atlas|chorus|circus|walrus|^lens)es$`), "$1"},
+	{regexp.MustCompile(`(^movie|^cookie|^zombie|^calorie|^pie|^tie|^ache|^cache|^niche|^avalanche)s$`), "$1"},
+	{regexp.MustCompile(`(^menu|^emu|^guru|^gnu|^haiku|^tofu|^tutu)s$`), "$1"},
+	// Already singular.
+	{regexp.MustCompile(`(ss|us|is)$`), "$1"},
+	{regexp.MustCompile(`([^aeiouy]|qu)ies$`), "${1}y"},
+	{regexp.MustCompile(`(x|ch|ss|sh|zz)es$`), "$1"},
+	{regexp.MustCompile(`(her|potat|tomat|ech|vet)oes$`), "${1}o"},
+	{regexp.MustCompile(`s$`), ""},
+}
+
+// Singular returns the singular form of an English plural noun, used to name the path parameter holding an id
+// of a collection, like {book} in books/{book}. Overrides map plurals to their singular and take precedence
+// over the built-in rules. For camelCase and snake_case names only the last word is inflected, so
+// userAddresses becomes userAddress.
+func Singular(plural string, overrides map[string]string) string {
+	if singular, ok := overrides[plural]; ok {
+		return singular
+	}
+
+	prefix, word := splitLastWord(plural)
+	if word == "" {
+		return plural
+	}
+	lower := strings.ToLower(word)
+	if singular, ok := overrides[lower]; ok {
+		return prefix + matchCase(word, singular)
+	}
+	// Initialisms like IDs or APIs only take the plural s.
+	if pluralInitialism.MatchString(word) {
+		return prefix + strings.TrimSuffix(word, "s")
+	}
+	return prefix + matchCase(word, singularWord(lower))
+}
+
+func singularWord(word string) string {
+	if _, ok := uncountableNouns[word]; ok {
+		return word
+	}
+	if singular, ok := irregularPlurals[word]; ok {
+		return singular
+	}
+	for _, rule := range singularRules {
+		if rule.pattern.MatchString(word) {
+			return rule.pattern.ReplaceAllString(word, rule.replacement)
+		}
+	}
+	return word
+}
+
+// splitLastWord splits a camelCase, snake_case or kebab-case name before its last word.
+func splitLastWord(name string) (string, string) {
+	runes := []rune(name)
+	for i := len(runes) - 1; i > 0; i-- {
+		switch {
+		case runes[i-1] == '_' || runes[i-1] == '-' || runes[i-1] == '.':
+			return string(runes[:i]), string(runes[i:])
+		case unicode.IsUpper(runes[i]) && !unicode.IsUpper(runes[i-1]):
+			return string(runes[:i]), string(runes[i:])
+		}
+	}
+	return "", name
+}
+
+// matchCase gives the singular the capitalization of the original word.
+func matchCase(word, singular string) string {
+	runes := []rune(word)
+	switch {
+	case len(runes) > 1 && strings.ToUpper(word) == word:
+		return strings.ToUpper(singular)
+	case unicode.IsUpper(runes[0]):
+		s := []rune(singular)
+		s[0] = unicode.ToUpper(s[0])
+		return string(s)
+	}
+	return singular
+}
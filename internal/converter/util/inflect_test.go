@@ -0,0 +1,64 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSingular(t *testing.T) {
+	for plural, singular := range map[string]string{
+		"books":         "book",
+		"addresses":     "address",
+		"statuses":      "status",
+		"aliases":       "alias",
+		"people":        "person",
+		"children":      "child",
+		"shelves":       "shelf",
+		"knives":        "knife",
+		"archives":      "archive",
+		"categories":    "category",
+		"movies":        "movie",
+		"parties":       "party",
+		"entities":      "entity",
+		"abuses":        "abuse",
+		"caches":        "cache",
+		"boxes":         "box",
+		"processes":     "process",
+		"databases":     "database",
+		"indices":       "index",
+		"analyses":      "analysis",
+		"heroes":        "hero",
+		"series":        "series",
+		"data":          "data",
+		"status":        "status",
+		"coaches":       "coach",
+		"beaches":       "beach",
+		"approaches":    "approach",
+		"aches":         "ache",
+		"avalanches":    "avalanche",
+		"menus":         "menu",
+		"bonuses":       "bonus",
+		"atlases":       "atlas",
+		"octopuses":     "octopus",
+		"buses":         "bus",
+		"IDs":           "ID",
+		"APIs":          "API",
+		"userIDs":       "userID",
+		"ProjectURLs":   "ProjectURL",
+		"book":          "book",
+		"userAddresses": "userAddress",
+		"user_people":   "user_person",
+		"UserProfiles":  "UserProfile",
+		"":              "",
+	} {
+		assert.Equal(t, singular, Singular(plural, nil), plural)
+	}
+}
+
+func TestSingularOverrides(t *testing.T) {
+	overrides := map[string]string{"octopi": "octopus", "staff": "staffMember"}
+	assert.Equal(t, "octopus", Singular("octopi", overrides))
+	assert.Equal(t, "teamStaffMember", Singular("teamStaff", overrides))
+	assert.Equal(t, "book", Singular("books", overrides))
+}
@@ -0,0 +1,22 @@
+package util
+
+import (
+	"github.com/pubgo/protoc-gen-openapi/generator"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// GetMessageOptions returns the openapi.v3.message option of a message, or nil if it isn't set.
+func GetMessageOptions(md protoreflect.MessageDescriptor) *generator.Message {
+	messageOpts := md.Options()
+	if messageOpts == nil || !proto.HasExtension(messageOpts, generator.E_Message) {
+		return nil
+	}
+
+	messageOption, ok := proto.GetExtension(messageOpts, generator.E_Message).(*generator.Message)
+	if !ok {
+		return nil
+	}
+
+	return messageOption
+}
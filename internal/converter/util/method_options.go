@@ -0,0 +1,22 @@
+package util
+
+import (
+	"github.com/pubgo/protoc-gen-openapi/generator"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// GetMethodOptions returns the openapi.v3.method option of a method, or nil if it isn't set.
+func GetMethodOptions(method protoreflect.MethodDescriptor) *generator.Method {
+	methodOpts := method.Options()
+	if methodOpts == nil || !proto.HasExtension(methodOpts, generator.E_Method) {
+		return nil
+	}
+
+	methodOption, ok := proto.GetExtension(methodOpts, generator.E_Method).(*generator.Method)
+	if !ok {
+		return nil
+	}
+
+	return methodOption
+}
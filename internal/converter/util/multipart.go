@@ -0,0 +1,92 @@
+package util
+
+import (
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"github.com/pubgo/protoc-gen-openapi/internal/converter/options"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// IsMultipart reports if the HTTP request body of a method is documented as multipart/form-data.
+func IsMultipart(method protoreflect.MethodDescriptor) bool {
+	return GetMethodOptions(method).GetMultipart()
+}
+
+// MultipartMediaTypes documents a message sent as multipart/form-data. Bytes fields become binary file parts,
+// message fields become JSON-encoded parts and the other fields stay plain form fields. s is the inline schema
+// of the message, it isn't modified.
+func MultipartMediaTypes(opts options.Options, md protoreflect.MessageDescriptor, s *base.Schema) *orderedmap.Map[string, *v3.MediaType] {
+	form := *s
+	form.Properties = orderedmap.New[string, *base.SchemaProxy]()
+	encoding := orderedmap.New[string, *v3.Encoding]()
+
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		name := MakeFieldName(opts, field)
+		prop, ok := s.Properties.Get(name)
+		if !ok {
+			// Fields bound to the path are already left out of the schema.
+			continue
+		}
+
+		part := &v3.Encoding{}
+		switch {
+		case field.Kind() == protoreflect.BytesKind && !field.IsMap() && prop.Schema() != nil:
+			// Bytes are sent as the raw file content rather than base64.
+			file := *prop.Schema()
+			if field.IsList() {
+				file.Items = &base.DynamicValue[*base.SchemaProxy, bool]{
+					A: base.CreateSchemaProxy(&base.Schema{Type: []string{"string"}, Format: "binary"}),
+				}
+			} else {
+				file.Format = "binary"
+			}
+			prop = base.CreateSchemaProxy(&file)
+			part.ContentType = "application/octet-stream"
+		case field.Kind() == protoreflect.MessageKind && !IsWellKnown(field.Message()):
+			part.ContentType = "application/json"
+		}
+
+		fieldOpts := GetFieldOptions(field)
+		if contentType := fieldOpts.GetContentType(); contentType != "" {
+			part.ContentType = contentType
+		}
+		for _, header := range fieldOpts.GetPartHeaders() {
+			if part.Headers == nil {
+				part.Headers = orderedmap.New[string, *v3.Header]()
+			}
+			part.Headers.Set(header, &v3.Header{
+				Schema: base.CreateSchemaProxy(&base.Schema{Type: []string{"string"}}),
+			})
+		}
+
+		form.Properties.Set(name, prop)
+		if part.ContentType != "" || part.Headers != nil {
+			encoding.Set(name, part)
+		}
+	}
+
+	mediaType := &v3.MediaType{Schema: base.CreateSchemaProxy(&form)}
+	if encoding.Len() > 0 {
+		mediaType.Encoding = encoding
+	}
+	mediaTypes := orderedmap.New[string, *v3.MediaType]()
+	mediaTypes.Set("multipart/form-data", mediaType)
+	return mediaTypes
+}
+
+// RawBodyMediaTypes documents a bytes field sent as the whole HTTP body, which it is when the field has a
+// content_type option. It returns nil for fields that are JSON-encoded like the rest of the message.
+func RawBodyMediaTypes(field protoreflect.FieldDescriptor) *orderedmap.Map[string, *v3.MediaType] {
+	contentType := GetFieldOptions(field).GetContentType()
+	if field.Kind() != protoreflect.BytesKind || field.IsList() || contentType == "" {
+		return nil
+	}
+	mediaTypes := orderedmap.New[string, *v3.MediaType]()
+	mediaTypes.Set(contentType, &v3.MediaType{
+		Schema: base.CreateSchemaProxy(&base.Schema{Type: []string{"string"}, Format: "binary"}),
+	})
+	return mediaTypes
+}
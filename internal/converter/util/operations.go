@@ -0,0 +1,109 @@
+package util
+
+import (
+	"log/slog"
+
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"github.com/pb33f/libopenapi/utils"
+	"gopkg.in/yaml.v3"
+)
+
+// AdditionalOperationsExtension is the path item extension holding the operations of HTTP methods that have no
+// field on a path item, keyed by method like the additionalOperations of OpenAPI 3.2.
+const AdditionalOperationsExtension = "x-additional-operations"
+
+// AdditionalOperations holds the operations of HTTP methods that have no field on a path item, by path and
+// method. They stay operations until Render writes them into their path items, so they go through the same
+// passes as every other operation. A nil AdditionalOperations has none.
+type AdditionalOperations struct {
+	paths *orderedmap.Map[string, *orderedmap.Map[string, *v3.Operation]]
+}
+
+func NewAdditionalOperations() *AdditionalOperations {
+	return &AdditionalOperations{paths: orderedmap.New[string, *orderedmap.Map[string, *v3.Operation]]()}
+}
+
+// Set adds the operation of method at path, keeping the operation already there for the same method.
+func (a *AdditionalOperations) Set(path, method string, op *v3.Operation) {
+	operations, ok := a.paths.Get(path)
+	if !ok {
+		operations = orderedmap.New[string, *v3.Operation]()
+		a.paths.Set(path, operations)
+	}
+	if _, ok := operations.Get(method); !ok {
+		operations.Set(method, op)
+	}
+}
+
+// Get returns the operations at path by method, or nil if there are none.
+func (a *AdditionalOperations) Get(path string) *orderedmap.Map[string, *v3.Operation] {
+	if a == nil {
+		return nil
+	}
+	operations, _ := a.paths.Get(path)
+	return operations
+}
+
+// Operations returns every operation of the path item at path by method, the ones of HTTP methods without a
+// field last.
+func (a *AdditionalOperations) Operations(path string, item *v3.PathItem) *orderedmap.Map[string, *v3.Operation] {
+	operations := item.GetOperations()
+	for pair := a.Get(path).First(); pair != nil; pair = pair.Next() {
+		operations.Set(pair.Key(), pair.Value())
+	}
+	return operations
+}
+
+// Render writes the operations into the x-additional-operations extension of the path items of doc. It runs
+// when the document is written, once nothing changes the operations anymore.
+func (a *AdditionalOperations) Render(doc *v3.Document) {
+	if a == nil || doc == nil || doc.Paths == nil || doc.Paths.PathItems == nil {
+		return
+	}
+	for pair := doc.Paths.PathItems.First(); pair != nil; pair = pair.Next() {
+		operations := a.Get(pair.Key())
+		if operations == nil {
+			continue
+		}
+
+		rendered := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+		for op := operations.First(); op != nil; op = op.Next() {
+			node, err := op.Value().MarshalYAML()
+			if err != nil {
+				slog.Warn("unable to render operation", slog.String("method", op.Key()), slog.Any("error", err))
+				continue
+			}
+			if n, ok := node.(*yaml.Node); ok {
+				rendered.Content = append(rendered.Content, utils.CreateStringNode(op.Key()), n)
+			}
+		}
+		item := pair.Value()
+		if item.Extensions == nil {
+			item.Extensions = orderedmap.New[string, *yaml.Node]()
+		}
+		mergeAdditionalOperations(item.Extensions, rendered)
+	}
+}
+
+// mergeAdditionalOperations adds rendered operations to the x-additional-operations of a path item's extensions,
+// keeping the operations already there, like those of a base document.
+func mergeAdditionalOperations(extensions *orderedmap.Map[string, *yaml.Node], operations *yaml.Node) {
+	existing, ok := extensions.Get(AdditionalOperationsExtension)
+	if !ok || existing == nil || existing.Kind != yaml.MappingNode {
+		extensions.Set(AdditionalOperationsExtension, operations)
+		return
+	}
+	for i := 0; i+1 < len(operations.Content); i += 2 {
+		var found bool
+		for j := 0; j+1 < len(existing.Content); j += 2 {
+			if existing.Content[j].Value == operations.Content[i].Value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			existing.Content = append(existing.Content, operations.Content[i], operations.Content[i+1])
+		}
+	}
+}
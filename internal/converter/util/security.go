@@ -0,0 +1,72 @@
+package util
+
+import (
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"github.com/pubgo/protoc-gen-openapi/generator"
+)
+
+// SecurityScheme documents a security scheme declared with the openapi.v3.file option.
+func SecurityScheme(s *generator.AuthScheme) *v3.SecurityScheme {
+	scheme := &v3.SecurityScheme{Description: s.GetDescription()}
+	switch t := s.GetType().(type) {
+	case *generator.AuthScheme_ApiKey:
+		scheme.Type = "apiKey"
+		scheme.Name = t.ApiKey.GetName()
+		scheme.In = t.ApiKey.GetIn()
+	case *generator.AuthScheme_Bearer:
+		scheme.Type = "http"
+		scheme.Scheme = "bearer"
+		scheme.BearerFormat = t.Bearer.GetFormat()
+	case *generator.AuthScheme_Basic:
+		scheme.Type = "http"
+		scheme.Scheme = "basic"
+	case *generator.AuthScheme_Oauth2:
+		scheme.Type = "oauth2"
+		scheme.Flows = &v3.OAuthFlows{
+			Implicit:          oauthFlow(t.Oauth2.GetImplicit()),
+			Password:          oauthFlow(t.Oauth2.GetPassword()),
+			ClientCredentials: oauthFlow(t.Oauth2.GetClientCredentials()),
+			AuthorizationCode: oauthFlow(t.Oauth2.GetAuthorizationCode()),
+		}
+	case *generator.AuthScheme_OpenIdConnectUrl:
+		scheme.Type = "openIdConnect"
+		scheme.OpenIdConnectUrl = t.OpenIdConnectUrl
+	case *generator.AuthScheme_MutualTls:
+		scheme.Type = "mutualTLS"
+	}
+	return scheme
+}
+
+func oauthFlow(flow *generator.AuthFlow) *v3.OAuthFlow {
+	if flow == nil {
+		return nil
+	}
+	scopes := orderedmap.New[string, string]()
+	for _, scope := range flow.GetScopes() {
+		scopes.Set(scope.GetName(), scope.GetDescription())
+	}
+	return &v3.OAuthFlow{
+		AuthorizationUrl: flow.GetAuthorizationUrl(),
+		TokenUrl:         flow.GetTokenUrl(),
+		RefreshUrl:       flow.GetRefreshUrl(),
+		Scopes:           scopes,
+	}
+}
+
+// SecurityRequirements documents security requirements declared with the openapi.v3.file or openapi.v3.method
+// options. Each requirement is on a single scheme and any one of them grants access.
+func SecurityRequirements(reqs []*generator.AuthRequirement) []*base.SecurityRequirement {
+	if len(reqs) == 0 {
+		return nil
+	}
+	result := make([]*base.SecurityRequirement, len(reqs))
+	for i, req := range reqs {
+		requirements := orderedmap.New[string, []string]()
+		// Schemes without scopes are written as [] rather than null.
+		requirements.Set(req.GetScheme(), append([]string{}, req.GetScopes()...))
+		result[i] = &base.SecurityRequirement{Requirements: requirements}
+	}
+	return result
+}
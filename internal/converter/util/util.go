@@ -44,7 +44,7 @@ func AppendComponents(spec *v3.Document, components *v3.Components) {
 
 func TypeFieldDescription(opts options.Options, tt protoreflect.FieldDescriptor) string {
 	b := strings.Builder{}
-	b.WriteString(FormatComments(tt.ParentFile().SourceLocations().ByDescriptor(tt)))
+	b.WriteString(FormatComments(opts, tt.ParentFile().SourceLocations().ByDescriptor(tt)))
 	if opts.WithProtoAnnotations {
 		if b.Len() > 0 {
 			b.WriteByte(' ')
@@ -63,50 +63,56 @@ func TypeFieldDescription(opts options.Options, tt protoreflect.FieldDescriptor)
 	return b.String()
 }
 
-func FormatComments(loc protoreflect.SourceLocation) string {
-	var builder strings.Builder
-	if loc.LeadingComments != "" {
-		builder.WriteString(strings.TrimSpace(loc.LeadingComments))
-		builder.WriteString(" ")
-	}
-	if loc.TrailingComments != "" {
-		builder.WriteString(strings.TrimSpace(loc.TrailingComments))
-		builder.WriteString(" ")
-	}
-	return strings.TrimSpace(builder.String())
+// FormatComments returns the markdown of the comments of a descriptor, as parsed by ParseComments.
+func FormatComments(opts options.Options, loc protoreflect.SourceLocation) string {
+	return ParseComments(opts, loc).Text
 }
 
 func BoolPtr(b bool) *bool {
 	return &b
 }
 
+// SetNonNegative restricts an integer schema to values of zero and up. libopenapi drops a zero minimum when
+// rendering, so the equivalent exclusive bound is used instead.
+func SetNonNegative(s *base.Schema) {
+	s.ExclusiveMinimum = &base.DynamicValue[bool, float64]{N: 1, B: -1}
+}
+
 func FormatTypeRef(t string) string {
 	return strings.TrimPrefix(t, ".")
 }
 
+// IsMethodDeprecated reports if a method has the deprecated option or a @deprecated tag in its comments.
 func IsMethodDeprecated(md protoreflect.MethodDescriptor) *bool {
-	options, ok := md.Options().(*descriptorpb.MethodOptions)
-	if !ok || options == nil {
-		return nil
+	if options, ok := md.Options().(*descriptorpb.MethodOptions); ok && options != nil && options.Deprecated != nil {
+		return options.Deprecated
 	}
-	if options.Deprecated == nil {
-		return nil
+	if ParseComments(options.Options{}, md.ParentFile().SourceLocations().ByDescriptor(md)).Deprecated {
+		return BoolPtr(true)
 	}
-	return options.Deprecated
+	return nil
 }
 
+// IsFieldDeprecated reports if a field has the deprecated option or a @deprecated tag in its comments.
 func IsFieldDeprecated(fd protoreflect.FieldDescriptor) *bool {
-	options, ok := fd.Options().(*descriptorpb.FieldOptions)
-	if !ok || options == nil {
-		return nil
+	if options, ok := fd.Options().(*descriptorpb.FieldOptions); ok && options != nil && options.Deprecated != nil {
+		return options.Deprecated
 	}
-	if options.Deprecated == nil {
-		return nil
+	if ParseComments(options.Options{}, fd.ParentFile().SourceLocations().ByDescriptor(fd)).Deprecated {
+		return BoolPtr(true)
 	}
-	return options.Deprecated
+	return nil
 }
 
+// MethodToRequestBody documents the request body of a method. Multipart methods need the inline schema of the
+// body message in s, a reference is documented with the usual content types.
 func MethodToRequestBody(opts options.Options, method protoreflect.MethodDescriptor, s *base.SchemaProxy, isStreaming bool) *v3.RequestBody {
+	if IsMultipart(method) && !isStreaming && !s.IsReference() && s.Schema() != nil && s.Schema().Properties != nil {
+		return &v3.RequestBody{
+			Content:  MultipartMediaTypes(opts, method.Input(), s.Schema()),
+			Required: BoolPtr(true),
+		}
+	}
 	return &v3.RequestBody{
 		Content:  MakeMediaTypes(opts, s, true, isStreaming),
 		Required: BoolPtr(true),
@@ -151,18 +157,3 @@ func AppendStringDedupe(strs []string, str string) []string {
 	}
 	return append(strs, str)
 }
-
-// Singular returns the singular form of a given plural noun. .
-func Singular(plural string) string {
-
-	if strings.HasSuffix(plural, "ves") {
-		return strings.TrimSuffix(plural, "ves") + "f"
-	}
-	if strings.HasSuffix(plural, "ies") {
-		return strings.TrimSuffix(plural, "ies") + "y"
-	}
-	if strings.HasSuffix(plural, "s") {
-		return strings.TrimSuffix(plural, "s")
-	}
-	return plural
-}
@@ -0,0 +1,80 @@
+package util
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/pubgo/protoc-gen-openapi/internal/converter/options"
+	"google.golang.org/genproto/googleapis/api/visibility"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// VisibilityLabels returns the visibility labels a service, method, field or message is restricted to, from its
+// openapi.v3 option, its google.api visibility rule and an @internal directive in its comments, which stands for
+// INTERNAL. It returns nil if the descriptor isn't restricted.
+func VisibilityLabels(d protoreflect.Descriptor) []string {
+	var restriction string
+	switch d := d.(type) {
+	case protoreflect.ServiceDescriptor:
+		restriction = googleRestriction(d.Options(), visibility.E_ApiVisibility)
+	case protoreflect.MethodDescriptor:
+		restriction = GetMethodOptions(d).GetVisibility() + "," + googleRestriction(d.Options(), visibility.E_MethodVisibility)
+	case protoreflect.FieldDescriptor:
+		restriction = GetFieldOptions(d).GetVisibility() + "," + googleRestriction(d.Options(), visibility.E_FieldVisibility)
+	case protoreflect.MessageDescriptor:
+		restriction = GetMessageOptions(d).GetVisibility() + "," + googleRestriction(d.Options(), visibility.E_MessageVisibility)
+	}
+
+	var labels []string
+	for _, label := range strings.Split(restriction, ",") {
+		if label = strings.TrimSpace(label); label != "" {
+			labels = append(labels, label)
+		}
+	}
+	if ParseComments(options.Options{}, d.ParentFile().SourceLocations().ByDescriptor(d)).Internal {
+		labels = append(labels, "INTERNAL")
+	}
+	return labels
+}
+
+// googleRestriction returns the restriction of the google.api visibility rule ext in opts, if it is set.
+func googleRestriction(opts proto.Message, ext protoreflect.ExtensionType) string {
+	if opts == nil || !proto.HasExtension(opts, ext) {
+		return ""
+	}
+	rule, _ := proto.GetExtension(opts, ext).(*visibility.VisibilityRule)
+	return rule.GetRestriction()
+}
+
+// IsVisible reports if a service, method, field or message is documented for the labels of opts.Visibility. It
+// is when no labels are set, when it isn't restricted or when one of its labels is set, ignoring case, unless
+// opts.Exclude lists it. Fields of a message type that isn't visible aren't either.
+func IsVisible(opts options.Options, d protoreflect.Descriptor) bool {
+	if slices.Contains(opts.Exclude, d.FullName()) {
+		return false
+	}
+	if !opts.FiltersVisibility() {
+		return true
+	}
+	if field, ok := d.(protoreflect.FieldDescriptor); ok {
+		if field.IsMap() {
+			field = field.MapValue()
+		}
+		if msg := field.Message(); msg != nil && !IsVisible(opts, msg) {
+			return false
+		}
+	}
+	labels := VisibilityLabels(d)
+	if len(labels) == 0 || len(opts.Visibility) == 0 {
+		return true
+	}
+	for _, label := range labels {
+		for _, visible := range opts.Visibility {
+			if strings.EqualFold(label, visible) {
+				return true
+			}
+		}
+	}
+	return false
+}
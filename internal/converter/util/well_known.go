@@ -4,15 +4,16 @@ import (
 	"github.com/pb33f/libopenapi/datamodel/high/base"
 	"github.com/pb33f/libopenapi/orderedmap"
 	"github.com/pb33f/libopenapi/utils"
+	"github.com/pubgo/protoc-gen-openapi/internal/converter/options"
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"gopkg.in/yaml.v3"
 )
 
-var wellKnownToSchemaFns = map[string]func(protoreflect.MessageDescriptor) *IDSchema{
+var wellKnownToSchemaFns = map[string]func(options.Options, protoreflect.MessageDescriptor) *IDSchema{
 	"google.protobuf.Duration":  googleDuration,
 	"google.protobuf.Timestamp": googleTimestamp,
 	"google.protobuf.Empty":     googleEmpty,
-	"google.protobuf.Any":       func(_ protoreflect.MessageDescriptor) *IDSchema { return NewGoogleAny() },
+	"google.protobuf.Any":       func(_ options.Options, _ protoreflect.MessageDescriptor) *IDSchema { return NewGoogleAny() },
 	"google.protobuf.FieldMask": googleFieldmask,
 
 	// google.protobuf.[Type]Value
@@ -40,30 +41,30 @@ func IsWellKnown(msg protoreflect.MessageDescriptor) bool {
 	return ok
 }
 
-func WellKnownToSchema(msg protoreflect.MessageDescriptor) *IDSchema {
+func WellKnownToSchema(opts options.Options, msg protoreflect.MessageDescriptor) *IDSchema {
 	fn, ok := wellKnownToSchemaFns[string(msg.FullName())]
 	if !ok {
 		return nil
 	}
-	return fn(msg)
+	return fn(opts, msg)
 }
 
-func googleDuration(msg protoreflect.MessageDescriptor) *IDSchema {
+func googleDuration(opts options.Options, msg protoreflect.MessageDescriptor) *IDSchema {
 	return &IDSchema{
 		ID: string(msg.FullName()),
 		Schema: &base.Schema{
-			Description: FormatComments(msg.ParentFile().SourceLocations().ByDescriptor(msg)),
+			Description: FormatComments(opts, msg.ParentFile().SourceLocations().ByDescriptor(msg)),
 			Type:        []string{"string"},
 			Format:      "duration",
 		},
 	}
 }
 
-func googleTimestamp(msg protoreflect.MessageDescriptor) *IDSchema {
+func googleTimestamp(opts options.Options, msg protoreflect.MessageDescriptor) *IDSchema {
 	return &IDSchema{
 		ID: string(msg.FullName()),
 		Schema: &base.Schema{
-			Description: FormatComments(msg.ParentFile().SourceLocations().ByDescriptor(msg)),
+			Description: FormatComments(opts, msg.ParentFile().SourceLocations().ByDescriptor(msg)),
 			Type:        []string{"string"},
 			Format:      "date-time",
 			Examples: []*yaml.Node{
@@ -74,11 +75,11 @@ func googleTimestamp(msg protoreflect.MessageDescriptor) *IDSchema {
 	}
 }
 
-func googleValue(msg protoreflect.MessageDescriptor) *IDSchema {
+func googleValue(opts options.Options, msg protoreflect.MessageDescriptor) *IDSchema {
 	return &IDSchema{
 		ID: string(msg.FullName()),
 		Schema: &base.Schema{
-			Description: FormatComments(msg.ParentFile().SourceLocations().ByDescriptor(msg)),
+			Description: FormatComments(opts, msg.ParentFile().SourceLocations().ByDescriptor(msg)),
 			OneOf: []*base.SchemaProxy{
 				base.CreateSchemaProxy(&base.Schema{Type: []string{"null"}}),
 				base.CreateSchemaProxy(&base.Schema{Type: []string{"number"}}),
@@ -94,11 +95,11 @@ func googleValue(msg protoreflect.MessageDescriptor) *IDSchema {
 	}
 }
 
-func googleStruct(msg protoreflect.MessageDescriptor) *IDSchema {
+func googleStruct(opts options.Options, msg protoreflect.MessageDescriptor) *IDSchema {
 	return &IDSchema{
 		ID: string(msg.FullName()),
 		Schema: &base.Schema{
-			Description: FormatComments(msg.ParentFile().SourceLocations().ByDescriptor(msg)),
+			Description: FormatComments(opts, msg.ParentFile().SourceLocations().ByDescriptor(msg)),
 			Type:        []string{"object"},
 			AdditionalProperties: &base.DynamicValue[*base.SchemaProxy, bool]{
 				A: base.CreateSchemaProxyRef("#/components/schemas/google.protobuf.Value"),
@@ -107,62 +108,62 @@ func googleStruct(msg protoreflect.MessageDescriptor) *IDSchema {
 	}
 }
 
-func googleNullValue(msg protoreflect.MessageDescriptor) *IDSchema {
+func googleNullValue(opts options.Options, msg protoreflect.MessageDescriptor) *IDSchema {
 	return &IDSchema{
 		ID: string(msg.FullName()),
 		Schema: &base.Schema{
-			Description: FormatComments(msg.ParentFile().SourceLocations().ByDescriptor(msg)),
+			Description: FormatComments(opts, msg.ParentFile().SourceLocations().ByDescriptor(msg)),
 			Type:        []string{"null"},
 		},
 	}
 }
 
-func googleStringValue(msg protoreflect.MessageDescriptor) *IDSchema {
+func googleStringValue(opts options.Options, msg protoreflect.MessageDescriptor) *IDSchema {
 	return &IDSchema{
 		ID: string(msg.FullName()),
 		Schema: &base.Schema{
-			Description: FormatComments(msg.ParentFile().SourceLocations().ByDescriptor(msg)),
+			Description: FormatComments(opts, msg.ParentFile().SourceLocations().ByDescriptor(msg)),
 			Type:        []string{"string"},
 		},
 	}
 }
 
-func googleBoolValue(msg protoreflect.MessageDescriptor) *IDSchema {
+func googleBoolValue(opts options.Options, msg protoreflect.MessageDescriptor) *IDSchema {
 	return &IDSchema{
 		ID: string(msg.FullName()),
 		Schema: &base.Schema{
-			Description: FormatComments(msg.ParentFile().SourceLocations().ByDescriptor(msg)),
+			Description: FormatComments(opts, msg.ParentFile().SourceLocations().ByDescriptor(msg)),
 			Type:        []string{"boolean"},
 		},
 	}
 }
 
-func googleBytesValue(msg protoreflect.MessageDescriptor) *IDSchema {
+func googleBytesValue(opts options.Options, msg protoreflect.MessageDescriptor) *IDSchema {
 	return &IDSchema{
 		ID: string(msg.FullName()),
 		Schema: &base.Schema{
-			Description: FormatComments(msg.ParentFile().SourceLocations().ByDescriptor(msg)),
+			Description: FormatComments(opts, msg.ParentFile().SourceLocations().ByDescriptor(msg)),
 			Type:        []string{"string"},
 			Format:      "binary",
 		},
 	}
 }
 
-func google32BitNumberValue(msg protoreflect.MessageDescriptor) *IDSchema {
+func google32BitNumberValue(opts options.Options, msg protoreflect.MessageDescriptor) *IDSchema {
 	return &IDSchema{
 		ID: string(msg.FullName()),
 		Schema: &base.Schema{
-			Description: FormatComments(msg.ParentFile().SourceLocations().ByDescriptor(msg)),
+			Description: FormatComments(opts, msg.ParentFile().SourceLocations().ByDescriptor(msg)),
 			Type:        []string{"number"},
 		},
 	}
 }
 
-func google64BitNumberValue(msg protoreflect.MessageDescriptor) *IDSchema {
+func google64BitNumberValue(opts options.Options, msg protoreflect.MessageDescriptor) *IDSchema {
 	return &IDSchema{
 		ID: string(msg.FullName()),
 		Schema: &base.Schema{
-			Description: FormatComments(msg.ParentFile().SourceLocations().ByDescriptor(msg)),
+			Description: FormatComments(opts, msg.ParentFile().SourceLocations().ByDescriptor(msg)),
 			OneOf: []*base.SchemaProxy{
 				base.CreateSchemaProxy(&base.Schema{Type: []string{"string"}}),
 				base.CreateSchemaProxy(&base.Schema{Type: []string{"number"}}),
@@ -171,11 +172,11 @@ func google64BitNumberValue(msg protoreflect.MessageDescriptor) *IDSchema {
 	}
 }
 
-func googleEmpty(msg protoreflect.MessageDescriptor) *IDSchema {
+func googleEmpty(opts options.Options, msg protoreflect.MessageDescriptor) *IDSchema {
 	return &IDSchema{
 		ID: string(msg.FullName()),
 		Schema: &base.Schema{
-			Description: FormatComments(msg.ParentFile().SourceLocations().ByDescriptor(msg)),
+			Description: FormatComments(opts, msg.ParentFile().SourceLocations().ByDescriptor(msg)),
 			Type:        []string{"object"},
 		},
 	}
@@ -204,11 +205,11 @@ func NewGoogleAny() *IDSchema {
 	}
 }
 
-func googleFieldmask(msg protoreflect.MessageDescriptor) *IDSchema {
+func googleFieldmask(opts options.Options, msg protoreflect.MessageDescriptor) *IDSchema {
 	return &IDSchema{
 		ID: string(msg.FullName()),
 		Schema: &base.Schema{
-			Description: FormatComments(msg.ParentFile().SourceLocations().ByDescriptor(msg)),
+			Description: FormatComments(opts, msg.ParentFile().SourceLocations().ByDescriptor(msg)),
 			Type:        []string{"string"},
 		},
 	}
@@ -0,0 +1,30 @@
+package converter
+
+import (
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+
+	"github.com/pubgo/protoc-gen-openapi/internal/converter/options"
+)
+
+// applyVariant sets the info and servers of the variant a document is generated for, if any.
+func applyVariant(opts options.Options, doc *v3.Document) {
+	variant := opts.Variant
+	if variant == nil {
+		return
+	}
+	if variant.Info.Title != "" {
+		doc.Info.Title = variant.Info.Title
+	}
+	if variant.Info.Description != "" {
+		doc.Info.Description = variant.Info.Description
+	}
+	if variant.Info.Version != "" {
+		doc.Info.Version = variant.Info.Version
+	}
+	if len(variant.Servers) > 0 {
+		doc.Servers = make([]*v3.Server, len(variant.Servers))
+		for i, server := range variant.Servers {
+			doc.Servers[i] = &v3.Server{URL: server.URL, Description: server.Description}
+		}
+	}
+}
@@ -33,6 +33,26 @@ var conf = options.Config{
 	WithStreamingFlag:              flag.Bool("with-streaming", false, "Generate OpenAPI for client/server/bidirectional streaming RPCs (can be messy)."),
 	FullyQualifiedMessageNamesFlag: flag.Bool("fully-qualified-message-names", false, "Use the full path for message types: {pkg}.{name} instead of just the name. This is helpful if you are mixing types from multiple services."),
 	WithServiceDescriptions:        flag.Bool("with-service-descriptions", false, "set to true will cause service names and their comments to be added to the end of info.description"),
+	Int64EncodingFlag:              flag.String("int64-encoding", "both", "How 64-bit integers are represented: `both` (integer or string), `string` (as protojson emits them) or `integer`."),
+	WithSpecialFloatValuesFlag:     flag.Bool("with-special-float-values", false, "Allow the \"NaN\", \"Infinity\" and \"-Infinity\" strings protojson uses for non-finite float and double values."),
+	QueryParamsMaxDepthFlag:        flag.Int("query-params-max-depth", 0, "Maximum depth of nested messages flattened into dotted query parameters; deeper fields become one JSON-encoded parameter. 0 means no limit."),
+	DefaultPageSizeFlag:            flag.Int("default-page-size", 0, "Page size documented as the default for AIP-158 list methods. 0 leaves it out."),
+	MaxPageSizeFlag:                flag.Int("max-page-size", 0, "Largest page size documented for AIP-158 list methods. 0 leaves it out."),
+	SingularsFlag:                  flag.String("singulars", "", "Semicolon-separated plural:singular pairs naming the path parameters of collections, like `octopi:octopus`."),
+	EscapeVerbColonsFlag:           flag.Bool("escape-verb-colons", false, "Percent-encode the colon before custom verbs in paths, like `/v1/{name}%3Acancel`, for tools that read `:` as a path parameter."),
+	EnumOneOfFlag:                  flag.Bool("enum-one-of", false, "Document enum values as a `oneOf` of `const` schemas with titles instead of `x-enum-*` extensions."),
+	OmitEnumUnspecifiedFlag:        flag.Bool("omit-enum-unspecified", false, "Leave the zero value, like `STATE_UNSPECIFIED`, out of the allowed values of enums."),
+	WithoutEnumDefaultFlag:         flag.Bool("without-enum-default", false, "Don't document the zero value as the default of enums."),
+	TrimEnumPrefixFlag:             flag.Bool("trim-enum-prefix", false, "Strip the `ENUM_NAME_` prefix of enum values in their labels."),
+	ReportUnsecuredFlag:            flag.Bool("report-unsecured", false, "Log a warning for every operation without any security requirement, except the methods declared public."),
+	VisibilityFlag:                 flag.String("visibility", "", "Semicolon-separated visibility labels, like PUBLIC or INTERNAL, to generate documents for. Services, methods, fields and messages restricted to other labels are left out."),
+	VariantsFlag:                   flag.String("variants", "", "The path to a YAML file listing named variants, like public, partner and internal, each generated as its own document with its own services, visibility, exclusions, info, servers and path."),
+	FilterFlag:                     flag.String("filter", "", "Semicolon-separated include and exclude filters on method or service full names and HTTP paths. Globs or `re:` regular expressions, `!` excludes and `path:` matches paths, like `lava.*.v1.*;!*.Internal*;!path:/admin/**`."),
+	CommentDirectivesFlag:          flag.String("comment-directives", "", "Semicolon-separated prefixes of comment lines left out of descriptions, besides `buf:lint:`, `protolint:`, `TODO` and `FIXME`."),
+	WithDetachedCommentsFlag:       flag.Bool("with-detached-comments", false, "Add the comments detached from a declaration by a blank line to its description."),
+	CommentSummariesFlag:           flag.Bool("comment-summaries", false, "Use the first sentence of the comments of a method as the summary of its operation and the rest as its description."),
+	LocaleFlag:                     flag.String("locale", "", "The language, like `en` or `zh`, descriptions are documented in when comments have `@lang en:` or `@lang zh:` sections."),
+	LocaleFallbackFlag:             flag.String("locale-fallback", "", "The language of the descriptions whose comments have no section in the language of `locale`."),
 }
 
 var showVersion = flag.Bool("version", false, "print the version and exit")